@@ -0,0 +1,8 @@
+data "lark_department" "by_id" {
+  open_department_id = "od-test"
+}
+
+data "lark_department" "by_name" {
+  name                 = "Engineering"
+  parent_department_id = "0"
+}
@@ -0,0 +1,11 @@
+data "lark_department_users" "engineering" {
+  department_id  = "od-test"
+  recursive      = true
+  status         = "active"
+  employee_types = [1, 2]
+}
+
+resource "lark_group_chat_member" "engineering" {
+  group_chat_id = "oc_test"
+  member_ids    = data.lark_department_users.engineering.users[*].open_id
+}
@@ -0,0 +1,5 @@
+# Every department of the tenant.
+data "lark_departments" "all" {
+  parent_department_id = "0"
+  recursive            = true
+}
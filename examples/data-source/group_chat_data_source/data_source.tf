@@ -0,0 +1,7 @@
+data "lark_group_chat" "by_name" {
+  name = "On-call"
+}
+
+data "lark_group_chat" "by_id" {
+  chat_id = "oc_test"
+}
@@ -0,0 +1,8 @@
+data "lark_group_chat_link" "onboarding" {
+  group_chat_id   = "oc_test"
+  validity_period = "year"
+}
+
+output "onboarding_chat_link" {
+  value = data.lark_group_chat_link.onboarding.share_link
+}
@@ -0,0 +1,12 @@
+data "lark_group_chat_members" "oncall" {
+  group_chat_id = "oc_test"
+}
+
+# Give every administrator of the chat the on-call role.
+resource "lark_role_member" "oncall_leads" {
+  role_id = "role_id"
+  member_ids = [
+    for member in data.lark_group_chat_members.oncall.members : member.member_id
+    if member.is_admin && member.member_type == "user"
+  ]
+}
@@ -0,0 +1,3 @@
+data "lark_group_chats" "oncall" {
+  name_contains = "On-call"
+}
@@ -0,0 +1,7 @@
+data "lark_job_title" "by_id" {
+  job_title_id = "b5565c46b749"
+}
+
+data "lark_job_title" "by_name" {
+  name = "Engineer"
+}
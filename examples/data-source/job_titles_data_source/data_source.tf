@@ -0,0 +1,3 @@
+data "lark_job_titles" "enabled" {
+  enabled_only = true
+}
@@ -0,0 +1,7 @@
+data "lark_unit" "by_id" {
+  unit_id = "asia"
+}
+
+data "lark_unit" "by_name" {
+  name = "Asia"
+}
@@ -0,0 +1,8 @@
+data "lark_user" "example" {
+  open_id = "ou_test"
+}
+
+# The leader of the user approves their requests.
+data "lark_user" "approver" {
+  open_id = data.lark_user.example.leader_user_id
+}
@@ -1,2 +1,6 @@
-# User group can be imported by specifying the name.
-terraform import lark_department.example example
+# Department can be imported by specifying the open department ID.
+terraform import lark_department.example od-4e6ac4d14bcd5071a37a39de902c7141
+
+# Or by specifying the ID type and the custom department ID, separated by a slash.
+# The ID type is imported as department_id_type, so parent_department_id holds the custom department ID of the parent.
+terraform import lark_department.example department_id/digidawi
@@ -6,6 +6,7 @@ resource "lark_department" "example" {
     zh_cn = "测试ooo"
   }
   parent_department_id = "0"
+  department_id_type   = "open_department_id"
   department_id        = "digidawi"
   leader_user_id       = "ou_8fc0c1843c33c130462669327fb2113c"
   order                = "1"
@@ -18,4 +19,5 @@ resource "lark_department" "example" {
     }
   ]
   group_chat_employee_types = [1, 2]
+  deletion_policy           = "move_members_to_parent"
 }
@@ -26,4 +26,10 @@ resource "lark_group_chat" "example" {
   add_member_permission     = "all_members"
   share_card_permission     = "allowed"
   at_all_permission         = "all_members"
-}
\ No newline at end of file
+}
+# The bot creates the group chat and hands the ownership over to the team lead.
+resource "lark_group_chat" "team" {
+  name                  = "team chat"
+  owner_id              = "ou_team_lead"
+  leave_chat_on_destroy = true
+}
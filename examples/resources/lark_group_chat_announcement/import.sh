@@ -0,0 +1,2 @@
+# Group chat announcement can be imported by specifying the group chat ID.
+terraform import lark_group_chat_announcement.example oc_test
@@ -0,0 +1,14 @@
+resource "lark_group_chat" "example" {
+  name = "ini contoh"
+}
+
+resource "lark_group_chat_announcement" "example" {
+  group_chat_id  = lark_group_chat.example.chat_id
+  content_format = "markdown"
+  content        = <<-EOT
+    # Welcome
+    Please read the **onboarding guide** first.
+    - Be kind
+    - Keep it short
+  EOT
+}
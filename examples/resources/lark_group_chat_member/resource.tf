@@ -2,4 +2,13 @@ resource "lark_group_chat_member" "example" {
   group_chat_id     = "test"
   member_ids        = ["ou_test"]
   administrator_ids = ["ou_test"]
-}
\ No newline at end of file
+}
+
+# Every regular employee of the engineering department and its sub departments.
+resource "lark_group_chat_member" "engineering" {
+  group_chat_id         = "test"
+  member_ids            = ["ou_test"]
+  source_department_ids = ["od-test"]
+  source_recursive      = true
+  source_employee_types = [1]
+}
@@ -0,0 +1,2 @@
+# Group chat member binding can be imported by specifying the group chat ID and member ID.
+terraform import lark_group_chat_member_binding.example oc_test:ou_test
@@ -0,0 +1,4 @@
+resource "lark_group_chat_member_binding" "example" {
+  group_chat_id = "oc_test"
+  member_id     = "ou_test"
+}
@@ -0,0 +1,2 @@
+# Group chat menu can be imported by specifying the group chat ID.
+terraform import lark_group_chat_menu.example oc_test
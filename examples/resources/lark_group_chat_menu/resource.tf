@@ -0,0 +1,22 @@
+resource "lark_group_chat_menu" "example" {
+  group_chat_id = "oc_test"
+  items = [
+    {
+      name         = "Handbook"
+      redirect_url = "https://example.larksuite.com/wiki/handbook"
+    },
+    {
+      name = "Tools"
+      children = [
+        {
+          name         = "Dashboard"
+          redirect_url = "https://grafana.example.com/d/team"
+        },
+        {
+          name         = "On-call"
+          redirect_url = "https://example.larksuite.com/sheets/oncall"
+        },
+      ]
+    },
+  ]
+}
@@ -0,0 +1,2 @@
+# Group chat moderation can be imported by specifying the group chat ID.
+terraform import lark_group_chat_moderation.example oc_test
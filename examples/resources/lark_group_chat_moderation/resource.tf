@@ -0,0 +1,6 @@
+# Only the moderators may post in the company broadcast chat.
+resource "lark_group_chat_moderation" "example" {
+  group_chat_id      = "oc_test"
+  moderation_setting = "moderator_list"
+  moderator_ids      = ["ou_test"]
+}
@@ -0,0 +1,2 @@
+# Group chat tabs can be imported by specifying the group chat ID.
+terraform import lark_group_chat_tabs.example oc_test
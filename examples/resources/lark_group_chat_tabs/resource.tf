@@ -0,0 +1,20 @@
+resource "lark_group_chat_tabs" "example" {
+  group_chat_id = "oc_test"
+  tabs = [
+    {
+      name = "Runbook"
+      type = "doc"
+      url  = "https://example.larksuite.com/docx/doxcnRunbook"
+    },
+    {
+      name = "Dashboard"
+      type = "url"
+      url  = "https://grafana.example.com/d/team"
+    },
+    {
+      name = "On-call"
+      type = "doc"
+      url  = "https://example.larksuite.com/sheets/shtcnOnCall"
+    },
+  ]
+}
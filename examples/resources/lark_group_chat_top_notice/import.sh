@@ -0,0 +1,2 @@
+# Group chat top notice can be imported by specifying the group chat ID.
+terraform import lark_group_chat_top_notice.example oc_test
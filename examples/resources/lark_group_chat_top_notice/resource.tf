@@ -0,0 +1,22 @@
+resource "lark_group_chat_announcement" "incident" {
+  group_chat_id  = "oc_incident"
+  content_format = "markdown"
+  content        = <<-EOT
+    # Incident 2024-06-01
+    - **Commander**: on-call
+    - **Status page**: https://status.example.com
+  EOT
+}
+
+# Pin the announcement, so everyone joining the chat sees it first.
+resource "lark_group_chat_top_notice" "incident" {
+  group_chat_id = lark_group_chat_announcement.incident.group_chat_id
+  notice_type   = "announcement"
+}
+
+# Or pin a message instead.
+resource "lark_group_chat_top_notice" "example" {
+  group_chat_id = "oc_test"
+  notice_type   = "message"
+  message_id    = "om_test"
+}
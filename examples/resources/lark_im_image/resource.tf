@@ -0,0 +1,9 @@
+resource "lark_im_image" "avatar" {
+  source     = "${path.module}/avatar.png"
+  image_type = "avatar"
+}
+
+resource "lark_group_chat" "example" {
+  name   = "ini contoh"
+  avatar = lark_im_image.avatar.image_key
+}
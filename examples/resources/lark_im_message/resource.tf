@@ -0,0 +1,34 @@
+resource "lark_im_message" "welcome" {
+  receive_id = "oc_test"
+  msg_type   = "text"
+  content    = jsonencode({ text = "Welcome to the team! Please read the pinned handbook first." })
+  pinned     = true
+}
+
+resource "lark_im_message" "handbook" {
+  receive_id = "oc_test"
+  msg_type   = "post"
+  content = jsonencode({
+    en_us = {
+      title = "Handbook"
+      content = [
+        [
+          { tag = "text", text = "Start here: " },
+          { tag = "a", text = "team handbook", href = "https://example.larksuite.com/wiki/handbook" },
+        ],
+      ]
+    }
+  })
+}
+
+resource "lark_im_message" "checklist" {
+  receive_id      = "new.member@example.com"
+  receive_id_type = "email"
+  msg_type        = "interactive"
+  content = jsonencode({
+    config = { update_multi = true }
+    elements = [
+      { tag = "markdown", content = "**Onboarding checklist**\n- Set up your laptop\n- Join the on-call rotation" },
+    ]
+  })
+}
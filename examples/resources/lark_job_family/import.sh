@@ -0,0 +1,2 @@
+# Job family can be imported by specifying the job family ID.
+terraform import lark_job_family.example mga5oa8ayjlp9rb
@@ -0,0 +1,20 @@
+resource "lark_job_family" "engineering" {
+  name        = "Engineering"
+  description = "Engineering job family"
+}
+
+resource "lark_job_family" "example" {
+  name                 = "Backend"
+  parent_job_family_id = lark_job_family.engineering.job_family_id
+  status               = true
+  i18n_name = [
+    {
+      locale = "zh_cn"
+      value  = "后端"
+    },
+    {
+      locale = "en_us"
+      value  = "Backend"
+    }
+  ]
+}
@@ -0,0 +1,2 @@
+# Job level can be imported by specifying the job level ID.
+terraform import lark_job_level.example mga5oa8ayjlp9rb
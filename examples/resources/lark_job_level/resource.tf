@@ -0,0 +1,22 @@
+resource "lark_job_level" "example" {
+  name        = "Senior"
+  rank        = 3
+  description = "Senior individual contributor"
+  status      = true
+  i18n_name = [
+    {
+      locale = "zh_cn"
+      value  = "高级"
+    },
+    {
+      locale = "en_us"
+      value  = "Senior"
+    }
+  ]
+  i18n_description = [
+    {
+      locale = "en_us"
+      value  = "Senior individual contributor"
+    }
+  ]
+}
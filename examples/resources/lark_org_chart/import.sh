@@ -0,0 +1,2 @@
+# Org chart can be imported by specifying the open department ID of the root department.
+terraform import lark_org_chart.example 0
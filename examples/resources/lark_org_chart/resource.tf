@@ -0,0 +1,35 @@
+resource "lark_org_chart" "example" {
+  root_department_id = "0"
+  departments = [
+    {
+      department_id = "engineering"
+      name          = "Engineering"
+      i18n_name = {
+        en_us = "Engineering"
+        zh_cn = "工程"
+      }
+      leaders = [
+        {
+          leader_type = 1
+          leader_id   = "ou_8fc0c1843c33c130462669327fb2113c"
+        }
+      ]
+      children = [
+        {
+          department_id = "platform"
+          name          = "Platform"
+          order         = "1"
+        },
+        {
+          department_id = "data"
+          name          = "Data"
+          order         = "2"
+        }
+      ]
+    },
+    {
+      department_id = "sales"
+      name          = "Sales"
+    }
+  ]
+}
@@ -0,0 +1,2 @@
+# Role member binding can be imported by specifying the role ID and member ID.
+terraform import lark_role_member_binding.example test:ou_test
@@ -0,0 +1,4 @@
+resource "lark_role_member_binding" "example" {
+  role_id   = "test"
+  member_id = "ou_test"
+}
@@ -0,0 +1,2 @@
+# Unit can be imported by specifying the unit ID.
+terraform import lark_unit.example asia
@@ -0,0 +1,5 @@
+resource "lark_unit" "example" {
+  unit_id   = "asia"
+  name      = "Asia"
+  unit_type = "subsidiary"
+}
@@ -0,0 +1,2 @@
+# Unit department binding can be imported by specifying the unit ID and open department ID.
+terraform import lark_unit_department_binding.example asia:od-test
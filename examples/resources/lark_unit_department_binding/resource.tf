@@ -0,0 +1,5 @@
+resource "lark_unit_department_binding" "example" {
+  unit_id            = lark_unit.example.unit_id
+  department_id      = "od-test"
+  department_id_type = "open_department_id"
+}
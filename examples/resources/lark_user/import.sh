@@ -0,0 +1,2 @@
+# User can be imported by specifying the open ID.
+terraform import lark_user.example ou_test
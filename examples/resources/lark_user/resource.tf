@@ -0,0 +1,30 @@
+resource "lark_user" "example" {
+  name           = "Jane Doe"
+  email          = "jane.doe@example.com"
+  mobile         = "+6281234567890"
+  employee_type  = 1
+  job_title      = "Software Engineer"
+  city           = "Jakarta"
+  leader_user_id = "ou_test"
+
+  departments = [
+    {
+      department_id   = "od-test"
+      user_order      = 10
+      is_primary_dept = true
+    }
+  ]
+
+  custom_attrs = [
+    {
+      id   = "C-6965457429001748507"
+      type = "TEXT"
+      text = "Remote"
+    }
+  ]
+
+  # Resources owned by the user are handed over when it is deleted.
+  docs_acceptor_user_id            = "ou_test"
+  department_chat_acceptor_user_id = "ou_test"
+  calendar_acceptor_user_id        = "ou_test"
+}
@@ -0,0 +1,2 @@
+# User group member binding can be imported by specifying the user group ID and member ID.
+terraform import lark_user_group_member_binding.example test:ou_test
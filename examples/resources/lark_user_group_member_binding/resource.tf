@@ -0,0 +1,4 @@
+resource "lark_user_group_member_binding" "example" {
+  user_group_id = "test"
+  member_id     = "ou_test"
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"time"
 )
@@ -113,7 +114,15 @@ func (c *LarkClient) doSingleRequest(
 	url := fmt.Sprintf("%s%s", BASE_URL, path)
 
 	var bodyReader io.Reader
-	if requestBody != nil {
+	contentType := "application/json"
+	if multipartBody, ok := requestBody.(*MultipartRequest); ok {
+		body, multipartContentType, err := multipartBody.encode()
+		if err != nil {
+			return fmt.Errorf("error encoding multipart request: %w", err)
+		}
+		bodyReader = body
+		contentType = multipartContentType
+	} else if requestBody != nil {
 		jsonBody, err := json.Marshal(requestBody)
 		if err != nil {
 			return fmt.Errorf("error marshaling request: %w", err)
@@ -138,7 +147,7 @@ func (c *LarkClient) doSingleRequest(
 		req.Header.Set("Authorization", "Bearer "+token)
 	}
 
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", contentType)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
@@ -151,7 +160,7 @@ func (c *LarkClient) doSingleRequest(
 		if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
 			return fmt.Errorf("error response with status code %d", resp.StatusCode)
 		}
-		return fmt.Errorf("API error: code=%d, message=%s", errResp.Code, errResp.Msg)
+		return fmt.Errorf("API error: code=%d, message=%w", errResp.Code, &APIError{Code: errResp.Code, Msg: errResp.Msg})
 	}
 
 	if response != nil {
@@ -163,6 +172,33 @@ func (c *LarkClient) doSingleRequest(
 	return nil
 }
 
+// encode builds the multipart/form-data body, and returns it with its content type.
+// It's called on every attempt, so a retried request gets a fresh body.
+func (m *MultipartRequest) encode() (io.Reader, string, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	for key, value := range m.Fields {
+		if err := writer.WriteField(key, value); err != nil {
+			return nil, "", err
+		}
+	}
+
+	part, err := writer.CreateFormFile(m.FileField, m.FileName)
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := part.Write(m.File); err != nil {
+		return nil, "", err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return body, writer.FormDataContentType(), nil
+}
+
 // isConnectionError is a helper function to check if the error is a connection error.
 func isConnectionError(err error) bool {
 	if err == nil {
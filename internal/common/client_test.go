@@ -324,6 +324,39 @@ func TestLarkClient_doSingleRequest(t *testing.T) {
 			expectedError: nil,
 			wantErr:       false,
 		},
+		{
+			name: "success multipart request",
+			requestBody: &MultipartRequest{
+				Fields: map[string]string{
+					"image_type": "avatar",
+				},
+				FileField: "image",
+				FileName:  "avatar.png",
+				File:      []byte("image"),
+			},
+			authorizationHeader: TENANT_ACCESS_TOKEN,
+			mockFn: func() []*MockBuilder {
+				return []*MockBuilder{
+					Mock((*http.Client).Do).To(func(req *http.Request) (*http.Response, error) {
+						if err := req.ParseMultipartForm(1 << 20); err != nil {
+							return nil, err
+						}
+						if req.FormValue("image_type") != "avatar" {
+							return nil, fmt.Errorf("unexpected image_type: %s", req.FormValue("image_type"))
+						}
+						if _, header, err := req.FormFile("image"); err != nil || header.Filename != "avatar.png" {
+							return nil, fmt.Errorf("unexpected image file")
+						}
+						return &http.Response{
+							StatusCode: 200,
+							Body:       io.NopCloser(bytes.NewBufferString(`{"code": 0, "msg": "success"}`)),
+						}, nil
+					}),
+				}
+			},
+			expectedError: nil,
+			wantErr:       false,
+		},
 	}
 	for _, tt := range tests {
 		PatchConvey(tt.name, t, func() {
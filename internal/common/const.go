@@ -3,6 +3,11 @@
 
 package common
 
+// Provider Things.
+const (
+	PROVIDER_ADDRESS = "registry.terraform.io/aganisatria/lark"
+)
+
 // URL Things.
 const (
 	BASE_URL                 = "https://open.larksuite.com/open-apis"
@@ -17,6 +22,12 @@ const (
 	EXPLORER_FOLDER_API      = "/drive/explorer/v2/folder"
 	DOCS_FILE_API            = "/drive/v1/files"
 	WORKFORCE_TYPE_API       = "/contact/v3/employee_type_enums"
+	JOB_LEVEL_API            = "/contact/v3/job_levels"
+	JOB_FAMILY_API           = "/contact/v3/job_families"
+	JOB_TITLE_API            = "/contact/v3/job_titles"
+	IM_IMAGE_API             = "/im/v1/images"
+	IM_MESSAGE_API           = "/im/v1/messages"
+	IM_PIN_API               = "/im/v1/pins"
 )
 
 // HTTP Call Helpers.
@@ -36,6 +47,27 @@ const (
 	PUT    HTTPMethod = "PUT"
 )
 
+// Error codes of a message that can't be read anymore.
+// https://open.larksuite.com/document/server-docs/im-v1/message/get.
+const (
+	MESSAGE_RECALLED_CODE = 230011
+	MESSAGE_DELETED_CODE  = 230110
+)
+
+// Error codes of contact objects that don't exist anymore.
+const (
+	// https://open.larksuite.com/document/server-docs/contact-v3/user/get.
+	USER_NOT_FOUND_CODE = 41050
+	// https://open.larksuite.com/document/server-docs/contact-v3/department/get.
+	DEPARTMENT_NOT_FOUND_CODE = 40014
+	// https://open.larksuite.com/document/server-docs/contact-v3/unit/get.
+	UNIT_NOT_FOUND_CODE = 42102
+	// https://open.larksuite.com/document/server-docs/contact-v3/job_level/get.
+	JOB_LEVEL_NOT_FOUND_CODE = 1270005
+	// https://open.larksuite.com/document/server-docs/contact-v3/job_family/get.
+	JOB_FAMILY_NOT_FOUND_CODE = 1270006
+)
+
 type AuthorizationHeader string
 
 // Authorization Header.
@@ -67,16 +99,39 @@ type TerraformName string
 
 // Terraform Name.
 const (
-	DEPARTMENT        TerraformName = "department"
-	GROUP_CHAT        TerraformName = "group_chat"
-	GROUP_CHAT_MEMBER TerraformName = "group_chat_member"
-	ROLE              TerraformName = "role"
-	ROLE_MEMBER       TerraformName = "role_member"
-	USER_GROUP        TerraformName = "user_group"
-	USER_GROUP_MEMBER TerraformName = "user_group_member"
-	USER_BY_EMAIL     TerraformName = "user_by_email"
-	USER_BY_ID        TerraformName = "user_by_id"
-	WORKFORCE_TYPE    TerraformName = "workforce_type"
+	DEPARTMENT                TerraformName = "department"
+	DEPARTMENT_USERS          TerraformName = "department_users"
+	DEPARTMENTS               TerraformName = "departments"
+	GROUP_CHAT                TerraformName = "group_chat"
+	GROUP_CHATS               TerraformName = "group_chats"
+	GROUP_CHAT_ANNOUNCEMENT   TerraformName = "group_chat_announcement"
+	GROUP_CHAT_LINK           TerraformName = "group_chat_link"
+	GROUP_CHAT_MEMBER         TerraformName = "group_chat_member"
+	GROUP_CHAT_MEMBER_BINDING TerraformName = "group_chat_member_binding"
+	GROUP_CHAT_MEMBERS        TerraformName = "group_chat_members"
+	GROUP_CHAT_MENU           TerraformName = "group_chat_menu"
+	GROUP_CHAT_MODERATION     TerraformName = "group_chat_moderation"
+	GROUP_CHAT_TABS           TerraformName = "group_chat_tabs"
+	GROUP_CHAT_TOP_NOTICE     TerraformName = "group_chat_top_notice"
+	IM_IMAGE                  TerraformName = "im_image"
+	IM_MESSAGE                TerraformName = "im_message"
+	JOB_FAMILY                TerraformName = "job_family"
+	JOB_LEVEL                 TerraformName = "job_level"
+	JOB_TITLE                 TerraformName = "job_title"
+	JOB_TITLES                TerraformName = "job_titles"
+	ORG_CHART                 TerraformName = "org_chart"
+	ROLE                      TerraformName = "role"
+	ROLE_MEMBER               TerraformName = "role_member"
+	ROLE_MEMBER_BINDING       TerraformName = "role_member_binding"
+	UNIT                      TerraformName = "unit"
+	UNIT_DEPARTMENT_BINDING   TerraformName = "unit_department_binding"
+	USER                      TerraformName = "user"
+	USER_GROUP                TerraformName = "user_group"
+	USER_GROUP_MEMBER         TerraformName = "user_group_member"
+	USER_GROUP_MEMBER_BINDING TerraformName = "user_group_member_binding"
+	USER_BY_EMAIL             TerraformName = "user_by_email"
+	USER_BY_ID                TerraformName = "user_by_id"
+	WORKFORCE_TYPE            TerraformName = "workforce_type"
 )
 
 type DepartmentIDType string
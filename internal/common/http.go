@@ -209,20 +209,47 @@ func UsergroupMemberAddAPI(ctx context.Context, client *LarkClient, groupID stri
 }
 
 // https://open.larksuite.com/document/server-docs/contact-v3/group/group-member/simplelist.
+// It pages through every member of the user group with the given member type.
 func UsergroupMemberGetByMemberTypeAPI(ctx context.Context, client *LarkClient, groupID string, memberType string) (*UsergroupMemberGetResponse, error) {
-	response := &UsergroupMemberGetResponse{}
-	path := fmt.Sprintf("%s/%s/member/simplelist?member_type=%s", USERGROUP_API, groupID, memberType)
 	tflog.Info(ctx, "Getting User Group Member by Member Type")
+	var allMembers []UsergroupMember
+	pageToken := ""
 
-	err := client.DoTenantRequest(ctx, GET, path, nil, response)
-	if err != nil || response.Code != 0 {
-		tflog.Error(ctx, "Failed to get user group member", map[string]interface{}{
-			"error": err.Error(),
-		})
-		return nil, err
+	for {
+		response := &UsergroupMemberGetResponse{}
+		path := fmt.Sprintf("%s/%s/member/simplelist?member_type=%s&page_size=100", USERGROUP_API, groupID, memberType)
+		if pageToken != "" {
+			path += fmt.Sprintf("&page_token=%s", pageToken)
+		}
+
+		err := client.DoTenantRequest(ctx, GET, path, nil, response)
+		if err != nil {
+			tflog.Error(ctx, "Failed to get user group member", map[string]interface{}{"error": err.Error()})
+			return nil, err
+		}
+		if response.Code != 0 {
+			tflog.Error(ctx, "API returned an error when getting user group member", map[string]interface{}{"response": response})
+			return nil, fmt.Errorf("API error when getting user group member: %s", response.Msg)
+		}
+
+		allMembers = append(allMembers, response.Data.MemberList...)
+
+		if !response.Data.HasMore || response.Data.PageToken == "" {
+			break
+		}
+		pageToken = response.Data.PageToken
 	}
-	tflog.Info(ctx, "User Group Member Retrieved")
-	return response, nil
+
+	finalResponse := &UsergroupMemberGetResponse{
+		BaseResponse: BaseResponse{
+			Code: 0,
+			Msg:  "success",
+		},
+	}
+	finalResponse.Data.MemberList = allMembers
+
+	tflog.Info(ctx, "User Group Member Retrieved", map[string]interface{}{"total_members": len(allMembers)})
+	return finalResponse, nil
 }
 
 // https://open.larksuite.com/document/uAjLw4CM/ukTMukTMukTM/reference/contact-v3/group-member/batch_remove.
@@ -314,6 +341,157 @@ func GetUserIdByEmailsAPI(ctx context.Context, client *LarkClient, request UserI
 	return batchResponse, nil
 }
 
+// https://open.larksuite.com/document/server-docs/contact-v3/user/create.
+// The client token is set before sending, so a retry in DoRequest after a lost response doesn't create the user twice.
+func UserCreateAPI(ctx context.Context, client *LarkClient, request UserCreateRequest) (*UserGetResponse, error) {
+	response := &UserGetResponse{}
+	clientToken, err := NewUUID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate user client token: %w", err)
+	}
+	tflog.Info(ctx, "Creating User", map[string]interface{}{"client_token": clientToken})
+	path := fmt.Sprintf("%s?user_id_type=open_id&department_id_type=open_department_id&client_token=%s", USER_API, clientToken)
+
+	err = client.DoTenantRequest(ctx, POST, path, request, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to create user", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when creating user", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when creating user: %s", response.Msg)
+	}
+
+	tflog.Info(ctx, "User Created", map[string]interface{}{"open_id": response.Data.User.OpenID})
+	return response, nil
+}
+
+// https://open.larksuite.com/document/server-docs/contact-v3/user/get.
+func UserGetAPI(ctx context.Context, client *LarkClient, userID string, userIDType UserIDType) (*UserGetResponse, error) {
+	response := &UserGetResponse{}
+	tflog.Info(ctx, "Getting User", map[string]interface{}{"user_id": userID})
+	path := fmt.Sprintf("%s/%s?user_id_type=%s&department_id_type=open_department_id", USER_API, userID, userIDType)
+
+	err := client.DoTenantRequest(ctx, GET, path, nil, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to get user", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when getting user", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when getting user: %w", &APIError{Code: response.Code, Msg: response.Msg})
+	}
+
+	tflog.Info(ctx, "User Retrieved")
+	return response, nil
+}
+
+// https://open.larksuite.com/document/server-docs/contact-v3/user/patch.
+func UserUpdateAPI(ctx context.Context, client *LarkClient, openID string, request UserUpdateRequest) (*UserGetResponse, error) {
+	response := &UserGetResponse{}
+	tflog.Info(ctx, "Updating User", map[string]interface{}{"open_id": openID})
+	path := fmt.Sprintf("%s/%s?user_id_type=open_id&department_id_type=open_department_id", USER_API, openID)
+
+	err := client.DoTenantRequest(ctx, PATCH, path, request, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to update user", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when updating user", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when updating user: %s", response.Msg)
+	}
+
+	tflog.Info(ctx, "User Updated")
+	return response, nil
+}
+
+// https://open.larksuite.com/document/server-docs/contact-v3/user/patch.
+// Only the departments of the user are replaced, every other field is left untouched.
+func UserUpdateDepartmentsAPI(ctx context.Context, client *LarkClient, openID string, departmentIDs []string) (*UserGetResponse, error) {
+	response := &UserGetResponse{}
+	tflog.Info(ctx, "Updating User Departments", map[string]interface{}{"open_id": openID})
+	path := fmt.Sprintf("%s/%s?user_id_type=open_id&department_id_type=open_department_id", USER_API, openID)
+
+	err := client.DoTenantRequest(ctx, PATCH, path, UserDepartmentsUpdateRequest{DepartmentIDs: departmentIDs}, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to update user departments", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when updating user departments", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when updating user departments: %s", response.Msg)
+	}
+
+	tflog.Info(ctx, "User Departments Updated")
+	return response, nil
+}
+
+// https://open.larksuite.com/document/server-docs/contact-v3/user/delete.
+// Deleting a user resigns them, their resources are handed over to the acceptors in the request.
+func UserDeleteAPI(ctx context.Context, client *LarkClient, openID string, request UserDeleteRequest) (*BaseResponse, error) {
+	response := &BaseResponse{}
+	tflog.Info(ctx, "Deleting User", map[string]interface{}{"open_id": openID})
+	path := fmt.Sprintf("%s/%s?user_id_type=open_id", USER_API, openID)
+
+	err := client.DoTenantRequest(ctx, DELETE, path, request, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to delete user", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when deleting user", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when deleting user: %s", response.Msg)
+	}
+
+	tflog.Info(ctx, "User Deleted")
+	return response, nil
+}
+
+// https://open.larksuite.com/document/server-docs/contact-v3/user/find_by_department.
+// UserListByDepartmentAPI pages through the users directly under the department.
+func UserListByDepartmentAPI(ctx context.Context, client *LarkClient, departmentID string, departmentIDType DepartmentIDType, userIDType UserIDType) (*UserListResponse, error) {
+	tflog.Info(ctx, "Listing Department Users", map[string]interface{}{"department_id": departmentID})
+	var allUsers []User
+	pageToken := ""
+
+	for {
+		response := &UserListResponse{}
+		path := fmt.Sprintf("%s/find_by_department?department_id=%s&department_id_type=%s&user_id_type=%s&page_size=50", USER_API, departmentID, departmentIDType, userIDType)
+		if pageToken != "" {
+			path += fmt.Sprintf("&page_token=%s", pageToken)
+		}
+
+		err := client.DoTenantRequest(ctx, GET, path, nil, response)
+		if err != nil {
+			tflog.Error(ctx, "Failed to list department users", map[string]interface{}{"error": err.Error()})
+			return nil, err
+		}
+		if response.Code != 0 {
+			tflog.Error(ctx, "API returned an error when listing department users", map[string]interface{}{"response": response})
+			return nil, fmt.Errorf("API error when listing department users: %s", response.Msg)
+		}
+
+		allUsers = append(allUsers, response.Data.Items...)
+
+		if !response.Data.HasMore || response.Data.PageToken == "" {
+			break
+		}
+		pageToken = response.Data.PageToken
+	}
+
+	finalResponse := &UserListResponse{
+		BaseResponse: BaseResponse{
+			Code: 0,
+			Msg:  "success",
+		},
+	}
+	finalResponse.Data.Items = allUsers
+
+	tflog.Info(ctx, "Department Users Listed", map[string]interface{}{"total_users": len(allUsers)})
+	return finalResponse, nil
+}
+
 // GROUP CHAT API.
 // https://open.larksuite.com/document/server-docs/group/chat/create.
 func GroupChatCreateAPI(ctx context.Context, client *LarkClient, request GroupChatCreateRequest) (*GroupChatCreateResponse, error) {
@@ -369,164 +547,492 @@ func GroupChatUpdateAPI(ctx context.Context, client *LarkClient, chatID string,
 }
 
 // https://open.larksuite.com/document/server-docs/group/chat/get.
+// The owner and the user managers are returned with their open IDs.
 func GroupChatGetAPI(ctx context.Context, client *LarkClient, chatID string) (*GroupChatGetResponse, error) {
-	response := &GroupChatGetResponse{}
-	tflog.Info(ctx, "Getting Group Chat")
-	path := fmt.Sprintf("%s/%s", GROUP_CHAT_API, chatID)
+	return GroupChatGetWithUserIDTypeAPI(ctx, client, chatID, OPEN_ID)
+}
 
-	err := client.DoTenantRequest(ctx, GET, path, nil, response)
-	if err != nil || response.Code != 0 {
-		tflog.Error(ctx, "Failed to get group chat", map[string]interface{}{
-			"error": err.Error(),
-		})
-		return nil, err
-	}
-	tflog.Info(ctx, "Group Chat Retrieved")
-	return response, nil
+// https://open.larksuite.com/document/server-docs/group/chat/list.
+// It returns every group chat the bot belongs to.
+func GroupChatListAPI(ctx context.Context, client *LarkClient) (*GroupChatListResponse, error) {
+	return groupChatListRequest(ctx, client, GROUP_CHAT_API+"?page_size=100", "listing")
 }
 
-// GROUP CHAT MEMBER API.
-// https://open.larksuite.com/document/server-docs/group/chat-member/get.
-func GroupChatMemberGetAPI(ctx context.Context, client *LarkClient, chatID string) (*GroupChatMemberGetResponse, error) {
-	var allMembers []ListMember
-	pageSize := 100
+// https://open.larksuite.com/document/server-docs/group/chat/search.
+// Lark matches the query against the name and members of the group chats the bot can see.
+func GroupChatSearchAPI(ctx context.Context, client *LarkClient, query string) (*GroupChatListResponse, error) {
+	path := fmt.Sprintf("%s/search?page_size=100&query=%s", GROUP_CHAT_API, url.QueryEscape(query))
+	return groupChatListRequest(ctx, client, path, "searching")
+}
+
+// groupChatListRequest pages through the group chats of the list and search APIs, since both return the same items.
+func groupChatListRequest(ctx context.Context, client *LarkClient, basePath string, action string) (*GroupChatListResponse, error) {
+	tflog.Info(ctx, fmt.Sprintf("Group Chats: %s", action))
+	var allChats []GroupChatListItem
 	pageToken := ""
 
 	for {
-		response := &GroupChatMemberGetResponse{}
-		path := fmt.Sprintf("%s/%s/members?page_size=%d", GROUP_CHAT_API, chatID, pageSize)
-
+		response := &GroupChatListResponse{}
+		path := basePath
 		if pageToken != "" {
 			path += fmt.Sprintf("&page_token=%s", pageToken)
 		}
 
 		err := client.DoTenantRequest(ctx, GET, path, nil, response)
-		if err != nil || response.Code != 0 {
-			tflog.Error(ctx, "Failed to get user groups", map[string]interface{}{
-				"error": err.Error(),
-			})
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Failed %s group chats", action), map[string]interface{}{"error": err.Error()})
 			return nil, err
 		}
+		if response.Code != 0 {
+			tflog.Error(ctx, fmt.Sprintf("API returned an error when %s group chats", action), map[string]interface{}{"response": response})
+			return nil, fmt.Errorf("API error when %s group chats: %s", action, response.Msg)
+		}
 
-		allMembers = append(allMembers, response.Data.Items...)
+		allChats = append(allChats, response.Data.Items...)
 
-		if response.Data.PageToken == "" {
+		if !response.Data.HasMore || response.Data.PageToken == "" {
 			break
 		}
-
 		pageToken = response.Data.PageToken
 	}
 
-	finalResponse := &GroupChatMemberGetResponse{
+	finalResponse := &GroupChatListResponse{
 		BaseResponse: BaseResponse{
 			Code: 0,
 			Msg:  "success",
 		},
-		Data: struct {
-			Items       []ListMember `json:"items"`
-			PageToken   string       `json:"page_token"`
-			HasMore     bool         `json:"has_more"`
-			MemberTotal int64        `json:"member_total"`
-		}{
-			Items:       allMembers,
-			PageToken:   "",
-			HasMore:     false,
-			MemberTotal: int64(len(allMembers)),
-		},
 	}
+	finalResponse.Data.Items = allChats
 
-	tflog.Info(ctx, "All User Groups Retrieved", map[string]interface{}{
-		"total_members": len(allMembers),
-	})
-
+	tflog.Info(ctx, "Group Chats Retrieved", map[string]interface{}{"total_chats": len(allChats)})
 	return finalResponse, nil
 }
 
-// https://open.larksuite.com/document/server-docs/group/chat-member/create.
-func GroupChatMemberAddAPI(ctx context.Context, client *LarkClient, chatID string, request GroupChatMemberRequest) (*GroupChatMemberAddResponse, error) {
-	fullResponse := GroupChatMemberAddResponse{}
-	tflog.Info(ctx, "Adding Group Member")
-	// there are 3 succeed_type: 0, 1, 2.
-	// 0: When there is a separated ID, other available IDs will be pulled into the group chat, and a successful response will be returned.
-	// 1: APull all the available IDs in the parameters into the group chat, return the successful response of pulling the group, and show the remaining unavailable IDs and reasons.
-	// 2: As long as there is any unavailable ID in the parameter, the group will fail, an error response will be returned, and the unavailable ID will be displayed.
-	path := fmt.Sprintf("%s/%s/members?succeed_type=2", GROUP_CHAT_API, chatID)
+// https://open.larksuite.com/document/server-docs/group/chat/get.
+// The owner and the user managers are returned with the given user ID type.
+func GroupChatGetWithUserIDTypeAPI(ctx context.Context, client *LarkClient, chatID string, userIDType UserIDType) (*GroupChatGetResponse, error) {
+	response := &GroupChatGetResponse{}
+	tflog.Info(ctx, "Getting Group Chat", map[string]interface{}{"user_id_type": userIDType})
+	path := fmt.Sprintf("%s/%s?user_id_type=%s", GROUP_CHAT_API, chatID, userIDType)
 
-	botList, personList, err := splitUserAndBotList(request.IDList)
+	err := client.DoTenantRequest(ctx, GET, path, nil, response)
 	if err != nil {
+		tflog.Error(ctx, "Failed to get group chat", map[string]interface{}{"error": err.Error()})
 		return nil, err
 	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when getting group chat", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when getting group chat: %s", response.Msg)
+	}
 
-	// Up to 50 users or 5 bots can be specified for each request.
-	for i := 0; i < len(botList); i += 5 {
-		end := i + 5
-		if end > len(botList) {
-			end = len(botList)
-		}
+	tflog.Info(ctx, "Group Chat Retrieved")
+	return response, nil
+}
 
-		batchRequest := GroupChatMemberRequest{
-			IDList: botList[i:end],
-		}
+// https://open.larksuite.com/document/server-docs/group/chat/link.
+func GroupChatLinkAPI(ctx context.Context, client *LarkClient, chatID string, request GroupChatLinkRequest) (*GroupChatLinkResponse, error) {
+	response := &GroupChatLinkResponse{}
+	tflog.Info(ctx, "Getting Group Chat Link", map[string]interface{}{"validity_period": request.ValidityPeriod})
+	path := fmt.Sprintf("%s/%s/link", GROUP_CHAT_API, chatID)
 
-		response := &GroupChatMemberAddResponse{}
+	err := client.DoTenantRequest(ctx, POST, path, request, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to get group chat link", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when getting group chat link", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when getting group chat link: %s", response.Msg)
+	}
 
-		path = fmt.Sprintf("%s?member_id_type=app_id", path)
+	tflog.Info(ctx, "Group Chat Link Retrieved", map[string]interface{}{"expire_time": response.Data.ExpireTime})
+	return response, nil
+}
 
-		err := client.DoTenantRequest(ctx, POST, path, batchRequest, response)
-		if err != nil || response.Code != 0 {
-			tflog.Error(ctx, "Failed to add bot members", map[string]interface{}{
-				"error": err.Error(),
-			})
-			return nil, err
-		}
+// GROUP CHAT ANNOUNCEMENT API.
+// https://open.larksuite.com/document/server-docs/group/chat-announcement/get.
+func GroupChatAnnouncementGetAPI(ctx context.Context, client *LarkClient, chatID string) (*GroupChatAnnouncementGetResponse, error) {
+	response := &GroupChatAnnouncementGetResponse{}
+	tflog.Info(ctx, "Getting Group Chat Announcement")
+	path := fmt.Sprintf("%s/%s/announcement", GROUP_CHAT_API, chatID)
 
-		fullResponse.Data.InvalidIDList = append(fullResponse.Data.InvalidIDList, response.Data.InvalidIDList...)
+	err := client.DoTenantRequest(ctx, GET, path, nil, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to get group chat announcement", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when getting group chat announcement", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when getting group chat announcement: %s", response.Msg)
 	}
 
-	for i := 0; i < len(personList); i += 50 {
-		end := i + 50
-		if end > len(personList) {
-			end = len(personList)
-		}
+	tflog.Info(ctx, "Group Chat Announcement Retrieved", map[string]interface{}{"revision": response.Data.Revision})
+	return response, nil
+}
 
-		batchRequest := GroupChatMemberRequest{
-			IDList: personList[i:end],
-		}
+// https://open.larksuite.com/document/server-docs/group/chat-announcement/patch.
+func GroupChatAnnouncementUpdateAPI(ctx context.Context, client *LarkClient, chatID string, request GroupChatAnnouncementUpdateRequest) (*BaseResponse, error) {
+	response := &BaseResponse{}
+	tflog.Info(ctx, "Updating Group Chat Announcement", map[string]interface{}{"revision": request.Revision})
+	path := fmt.Sprintf("%s/%s/announcement", GROUP_CHAT_API, chatID)
 
-		response := &GroupChatMemberAddResponse{}
+	err := client.DoTenantRequest(ctx, PATCH, path, request, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to update group chat announcement", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when updating group chat announcement", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when updating group chat announcement: %s", response.Msg)
+	}
 
-		err := client.DoTenantRequest(ctx, POST, path, batchRequest, response)
-		if err != nil || response.Code != 0 {
-			tflog.Error(ctx, "Failed to add user members", map[string]interface{}{
-				"error": err.Error(),
-			})
-			return nil, err
-		}
+	tflog.Info(ctx, "Group Chat Announcement Updated")
+	return response, nil
+}
 
-		fullResponse.Data.InvalidIDList = append(fullResponse.Data.InvalidIDList, response.Data.InvalidIDList...)
-	}
+// GROUP CHAT TAB API.
+// https://open.larksuite.com/document/server-docs/group/chat-tab/list_tabs.
+func GroupChatTabListAPI(ctx context.Context, client *LarkClient, chatID string) (*GroupChatTabsResponse, error) {
+	path := fmt.Sprintf("%s/%s/chat_tabs/list_tabs", GROUP_CHAT_API, chatID)
+	return groupChatTabRequest(ctx, client, GET, path, nil, "listing")
+}
 
-	tflog.Info(ctx, "Group Member Added")
-	return &fullResponse, nil
+// https://open.larksuite.com/document/server-docs/group/chat-tab/create.
+func GroupChatTabCreateAPI(ctx context.Context, client *LarkClient, chatID string, request GroupChatTabsRequest) (*GroupChatTabsResponse, error) {
+	path := fmt.Sprintf("%s/%s/chat_tabs", GROUP_CHAT_API, chatID)
+	return groupChatTabRequest(ctx, client, POST, path, request, "creating")
 }
 
-// https://open.larksuite.com/document/server-docs/group/chat-member/delete.
-func GroupChatMemberDeleteAPI(ctx context.Context, client *LarkClient, chatID string, request GroupChatMemberRequest) (*GroupChatMemberRemoveResponse, error) {
-	fullResponse := GroupChatMemberRemoveResponse{}
-	tflog.Info(ctx, "Deleting Group Members")
-	path := fmt.Sprintf("%s/%s/members", GROUP_CHAT_API, chatID)
+// https://open.larksuite.com/document/server-docs/group/chat-tab/update_tabs.
+func GroupChatTabUpdateAPI(ctx context.Context, client *LarkClient, chatID string, request GroupChatTabsRequest) (*GroupChatTabsResponse, error) {
+	path := fmt.Sprintf("%s/%s/chat_tabs/update_tabs", GROUP_CHAT_API, chatID)
+	return groupChatTabRequest(ctx, client, POST, path, request, "updating")
+}
 
-	botList, personList, err := splitUserAndBotList(request.IDList)
+// https://open.larksuite.com/document/server-docs/group/chat-tab/delete_tabs.
+func GroupChatTabDeleteAPI(ctx context.Context, client *LarkClient, chatID string, request GroupChatTabIDsRequest) (*GroupChatTabsResponse, error) {
+	path := fmt.Sprintf("%s/%s/chat_tabs/delete_tabs", GROUP_CHAT_API, chatID)
+	return groupChatTabRequest(ctx, client, DELETE, path, request, "deleting")
+}
+
+// https://open.larksuite.com/document/server-docs/group/chat-tab/sort_tabs.
+func GroupChatTabSortAPI(ctx context.Context, client *LarkClient, chatID string, request GroupChatTabIDsRequest) (*GroupChatTabsResponse, error) {
+	path := fmt.Sprintf("%s/%s/chat_tabs/sort_tabs", GROUP_CHAT_API, chatID)
+	return groupChatTabRequest(ctx, client, POST, path, request, "sorting")
+}
+
+// groupChatTabRequest does the request of the chat tab APIs, since all of them return the chat tabs after the change.
+func groupChatTabRequest(ctx context.Context, client *LarkClient, method HTTPMethod, path string, request interface{}, action string) (*GroupChatTabsResponse, error) {
+	response := &GroupChatTabsResponse{}
+	tflog.Info(ctx, fmt.Sprintf("Group Chat Tabs: %s", action))
+
+	err := client.DoTenantRequest(ctx, method, path, request, response)
 	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Failed %s group chat tabs", action), map[string]interface{}{"error": err.Error()})
 		return nil, err
 	}
+	if response.Code != 0 {
+		tflog.Error(ctx, fmt.Sprintf("API returned an error when %s group chat tabs", action), map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when %s group chat tabs: %s", action, response.Msg)
+	}
 
-	// Up to 50 users or 5 bots can be specified for each request.
-	for i := 0; i < len(botList); i += 5 {
-		end := i + 5
-		if end > len(botList) {
-			end = len(botList)
-		}
+	tflog.Info(ctx, "Group Chat Tabs Retrieved", map[string]interface{}{"total_tabs": len(response.Data.ChatTabs)})
+	return response, nil
+}
+
+// GROUP CHAT MENU API.
+// https://open.larksuite.com/document/server-docs/group/chat-menu_tree/get.
+func GroupChatMenuTreeGetAPI(ctx context.Context, client *LarkClient, chatID string) (*GroupChatMenuTreeResponse, error) {
+	path := fmt.Sprintf("%s/%s/menu_tree", GROUP_CHAT_API, chatID)
+	return groupChatMenuTreeRequest(ctx, client, GET, path, nil, "getting")
+}
+
+// https://open.larksuite.com/document/server-docs/group/chat-menu_tree/create.
+func GroupChatMenuTreeCreateAPI(ctx context.Context, client *LarkClient, chatID string, request GroupChatMenuTreeRequest) (*GroupChatMenuTreeResponse, error) {
+	path := fmt.Sprintf("%s/%s/menu_tree", GROUP_CHAT_API, chatID)
+	return groupChatMenuTreeRequest(ctx, client, POST, path, request, "creating")
+}
+
+// https://open.larksuite.com/document/server-docs/group/chat-menu_tree/delete.
+func GroupChatMenuTreeDeleteAPI(ctx context.Context, client *LarkClient, chatID string, request GroupChatMenuTopLevelIDsRequest) (*GroupChatMenuTreeResponse, error) {
+	path := fmt.Sprintf("%s/%s/menu_tree", GROUP_CHAT_API, chatID)
+	return groupChatMenuTreeRequest(ctx, client, DELETE, path, request, "deleting")
+}
+
+// https://open.larksuite.com/document/server-docs/group/chat-menu_tree/sort.
+func GroupChatMenuTreeSortAPI(ctx context.Context, client *LarkClient, chatID string, request GroupChatMenuTopLevelIDsRequest) (*GroupChatMenuTreeResponse, error) {
+	path := fmt.Sprintf("%s/%s/menu_tree/sort", GROUP_CHAT_API, chatID)
+	return groupChatMenuTreeRequest(ctx, client, POST, path, request, "sorting")
+}
+
+// groupChatMenuTreeRequest does the request of the chat menu tree APIs, since all of them return the menu tree after the change.
+func groupChatMenuTreeRequest(ctx context.Context, client *LarkClient, method HTTPMethod, path string, request interface{}, action string) (*GroupChatMenuTreeResponse, error) {
+	response := &GroupChatMenuTreeResponse{}
+	tflog.Info(ctx, fmt.Sprintf("Group Chat Menu Tree: %s", action))
+
+	err := client.DoTenantRequest(ctx, method, path, request, response)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Failed %s group chat menu tree", action), map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, fmt.Sprintf("API returned an error when %s group chat menu tree", action), map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when %s group chat menu tree: %s", action, response.Msg)
+	}
+
+	tflog.Info(ctx, "Group Chat Menu Tree Retrieved", map[string]interface{}{"total_top_levels": len(response.Data.MenuTree.ChatMenuTopLevels)})
+	return response, nil
+}
+
+// GROUP CHAT TOP NOTICE API.
+// https://open.larksuite.com/document/server-docs/group/chat-top_notice/put_top_notice.
+func GroupChatTopNoticePutAPI(ctx context.Context, client *LarkClient, chatID string, request GroupChatTopNoticeRequest) (*BaseResponse, error) {
+	response := &BaseResponse{}
+	tflog.Info(ctx, "Putting Group Chat Top Notice", map[string]interface{}{"chat_id": chatID})
+	path := fmt.Sprintf("%s/%s/top_notice/put_top_notice", GROUP_CHAT_API, chatID)
+
+	err := client.DoTenantRequest(ctx, POST, path, request, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to put group chat top notice", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when putting group chat top notice", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when putting group chat top notice: %s", response.Msg)
+	}
+
+	tflog.Info(ctx, "Group Chat Top Notice Put")
+	return response, nil
+}
+
+// https://open.larksuite.com/document/server-docs/group/chat-top_notice/delete_top_notice.
+func GroupChatTopNoticeDeleteAPI(ctx context.Context, client *LarkClient, chatID string) (*BaseResponse, error) {
+	response := &BaseResponse{}
+	tflog.Info(ctx, "Deleting Group Chat Top Notice", map[string]interface{}{"chat_id": chatID})
+	path := fmt.Sprintf("%s/%s/top_notice/delete_top_notice", GROUP_CHAT_API, chatID)
+
+	err := client.DoTenantRequest(ctx, POST, path, nil, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to delete group chat top notice", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when deleting group chat top notice", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when deleting group chat top notice: %s", response.Msg)
+	}
+
+	tflog.Info(ctx, "Group Chat Top Notice Deleted")
+	return response, nil
+}
+
+// GROUP CHAT MODERATION API.
+// https://open.larksuite.com/document/server-docs/group/chat/get-2.
+func GroupChatModerationGetAPI(ctx context.Context, client *LarkClient, chatID string) (*GroupChatModerationGetResponse, error) {
+	tflog.Info(ctx, "Getting Group Chat Moderation")
+	var allModerators []GroupChatModerator
+	moderationSetting := ""
+	pageToken := ""
+
+	for {
+		response := &GroupChatModerationGetResponse{}
+		path := fmt.Sprintf("%s/%s/moderation?user_id_type=%s&page_size=100", GROUP_CHAT_API, chatID, OPEN_ID)
+		if pageToken != "" {
+			path += fmt.Sprintf("&page_token=%s", pageToken)
+		}
+
+		err := client.DoTenantRequest(ctx, GET, path, nil, response)
+		if err != nil {
+			tflog.Error(ctx, "Failed to get group chat moderation", map[string]interface{}{"error": err.Error()})
+			return nil, err
+		}
+		if response.Code != 0 {
+			tflog.Error(ctx, "API returned an error when getting group chat moderation", map[string]interface{}{"response": response})
+			return nil, fmt.Errorf("API error when getting group chat moderation: %s", response.Msg)
+		}
+
+		moderationSetting = response.Data.ModerationSetting
+		allModerators = append(allModerators, response.Data.Items...)
+
+		if !response.Data.HasMore || response.Data.PageToken == "" {
+			break
+		}
+		pageToken = response.Data.PageToken
+	}
+
+	finalResponse := &GroupChatModerationGetResponse{
+		BaseResponse: BaseResponse{
+			Code: 0,
+			Msg:  "success",
+		},
+	}
+	finalResponse.Data.ModerationSetting = moderationSetting
+	finalResponse.Data.Items = allModerators
+
+	tflog.Info(ctx, "Group Chat Moderation Retrieved", map[string]interface{}{
+		"total_moderators": len(allModerators),
+	})
+	return finalResponse, nil
+}
+
+// https://open.larksuite.com/document/server-docs/group/chat/update-2.
+func GroupChatSpeechScopesUpdateAPI(ctx context.Context, client *LarkClient, chatID string, request GroupChatSpeechScopesUpdateRequest) (*BaseResponse, error) {
+	response := &BaseResponse{}
+	tflog.Info(ctx, "Updating Group Chat Moderation", map[string]interface{}{
+		"moderation_setting": request.ModerationSetting,
+	})
+	path := fmt.Sprintf("%s/%s/moderation?user_id_type=%s", GROUP_CHAT_API, chatID, OPEN_ID)
+
+	err := client.DoTenantRequest(ctx, PUT, path, request, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to update group chat moderation", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when updating group chat moderation", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when updating group chat moderation: %s", response.Msg)
+	}
+
+	tflog.Info(ctx, "Group Chat Moderation Updated")
+	return response, nil
+}
+
+// GROUP CHAT MEMBER API.
+// https://open.larksuite.com/document/server-docs/group/chat-member/get.
+// The members are returned with their open IDs.
+func GroupChatMemberGetAPI(ctx context.Context, client *LarkClient, chatID string) (*GroupChatMemberGetResponse, error) {
+	return GroupChatMemberListAPI(ctx, client, chatID, OPEN_ID)
+}
+
+// https://open.larksuite.com/document/server-docs/group/chat-member/get.
+// GroupChatMemberListAPI pages through the members of the group chat, with the given member ID type.
+func GroupChatMemberListAPI(ctx context.Context, client *LarkClient, chatID string, memberIDType UserIDType) (*GroupChatMemberGetResponse, error) {
+	tflog.Info(ctx, "Listing Group Chat Members", map[string]interface{}{"member_id_type": memberIDType})
+	var allMembers []ListMember
+	pageToken := ""
+
+	for {
+		response := &GroupChatMemberGetResponse{}
+		path := fmt.Sprintf("%s/%s/members?member_id_type=%s&page_size=100", GROUP_CHAT_API, chatID, memberIDType)
+		if pageToken != "" {
+			path += fmt.Sprintf("&page_token=%s", pageToken)
+		}
+
+		err := client.DoTenantRequest(ctx, GET, path, nil, response)
+		if err != nil {
+			tflog.Error(ctx, "Failed to list group chat members", map[string]interface{}{"error": err.Error()})
+			return nil, err
+		}
+		if response.Code != 0 {
+			tflog.Error(ctx, "API returned an error when listing group chat members", map[string]interface{}{"response": response})
+			return nil, fmt.Errorf("API error when listing group chat members: %s", response.Msg)
+		}
+
+		allMembers = append(allMembers, response.Data.Items...)
+
+		if !response.Data.HasMore || response.Data.PageToken == "" {
+			break
+		}
+		pageToken = response.Data.PageToken
+	}
+
+	finalResponse := &GroupChatMemberGetResponse{
+		BaseResponse: BaseResponse{
+			Code: 0,
+			Msg:  "success",
+		},
+	}
+	finalResponse.Data.Items = allMembers
+	finalResponse.Data.MemberTotal = int64(len(allMembers))
+
+	tflog.Info(ctx, "Group Chat Members Listed", map[string]interface{}{"total_members": len(allMembers)})
+	return finalResponse, nil
+}
+
+// https://open.larksuite.com/document/server-docs/group/chat-member/create.
+func GroupChatMemberAddAPI(ctx context.Context, client *LarkClient, chatID string, request GroupChatMemberRequest) (*GroupChatMemberAddResponse, error) {
+	fullResponse := GroupChatMemberAddResponse{}
+	tflog.Info(ctx, "Adding Group Member")
+	// there are 3 succeed_type: 0, 1, 2.
+	// 0: When there is a separated ID, other available IDs will be pulled into the group chat, and a successful response will be returned.
+	// 1: APull all the available IDs in the parameters into the group chat, return the successful response of pulling the group, and show the remaining unavailable IDs and reasons.
+	// 2: As long as there is any unavailable ID in the parameter, the group will fail, an error response will be returned, and the unavailable ID will be displayed.
+	path := fmt.Sprintf("%s/%s/members?succeed_type=2", GROUP_CHAT_API, chatID)
+
+	botList, personList, err := splitUserAndBotList(request.IDList)
+	if err != nil {
+		return nil, err
+	}
+
+	// Up to 50 users or 5 bots can be specified for each request.
+	for i := 0; i < len(botList); i += 5 {
+		end := i + 5
+		if end > len(botList) {
+			end = len(botList)
+		}
+
+		batchRequest := GroupChatMemberRequest{
+			IDList: botList[i:end],
+		}
+
+		response := &GroupChatMemberAddResponse{}
+
+		path = fmt.Sprintf("%s?member_id_type=app_id", path)
+
+		err := client.DoTenantRequest(ctx, POST, path, batchRequest, response)
+		if err != nil || response.Code != 0 {
+			tflog.Error(ctx, "Failed to add bot members", map[string]interface{}{
+				"error": err.Error(),
+			})
+			return nil, err
+		}
+
+		fullResponse.Data.InvalidIDList = append(fullResponse.Data.InvalidIDList, response.Data.InvalidIDList...)
+	}
+
+	for i := 0; i < len(personList); i += 50 {
+		end := i + 50
+		if end > len(personList) {
+			end = len(personList)
+		}
+
+		batchRequest := GroupChatMemberRequest{
+			IDList: personList[i:end],
+		}
+
+		response := &GroupChatMemberAddResponse{}
+
+		err := client.DoTenantRequest(ctx, POST, path, batchRequest, response)
+		if err != nil || response.Code != 0 {
+			tflog.Error(ctx, "Failed to add user members", map[string]interface{}{
+				"error": err.Error(),
+			})
+			return nil, err
+		}
+
+		fullResponse.Data.InvalidIDList = append(fullResponse.Data.InvalidIDList, response.Data.InvalidIDList...)
+	}
+
+	tflog.Info(ctx, "Group Member Added")
+	return &fullResponse, nil
+}
+
+// https://open.larksuite.com/document/server-docs/group/chat-member/delete.
+func GroupChatMemberDeleteAPI(ctx context.Context, client *LarkClient, chatID string, request GroupChatMemberRequest) (*GroupChatMemberRemoveResponse, error) {
+	fullResponse := GroupChatMemberRemoveResponse{}
+	tflog.Info(ctx, "Deleting Group Members")
+	path := fmt.Sprintf("%s/%s/members", GROUP_CHAT_API, chatID)
+
+	botList, personList, err := splitUserAndBotList(request.IDList)
+	if err != nil {
+		return nil, err
+	}
+
+	// Up to 50 users or 5 bots can be specified for each request.
+	for i := 0; i < len(botList); i += 5 {
+		end := i + 5
+		if end > len(botList) {
+			end = len(botList)
+		}
 
 		batchRequest := GroupChatMemberRequest{
 			IDList: botList[i:end],
@@ -791,20 +1297,47 @@ func RoleMemberAddAPI(ctx context.Context, client *LarkClient, roleID string, re
 }
 
 // https://open.larksuite.com/document/server-docs/contact-v3/functional_role-member/list
+// It pages through every member of the role.
 func RoleMemberGetAPI(ctx context.Context, client *LarkClient, roleID string) (*RoleMemberGetResponse, error) {
-	response := &RoleMemberGetResponse{}
 	tflog.Info(ctx, "Getting Role Member")
-	path := fmt.Sprintf("%s/%s/members", ROLE_API, roleID)
+	var allMembers []RoleMember
+	pageToken := ""
 
-	err := client.DoTenantRequest(ctx, GET, path, nil, response)
-	if err != nil || response.Code != 0 {
-		tflog.Error(ctx, "Failed to get role member", map[string]interface{}{
-			"error": err.Error(),
-		})
-		return nil, err
+	for {
+		response := &RoleMemberGetResponse{}
+		path := fmt.Sprintf("%s/%s/members?page_size=100", ROLE_API, roleID)
+		if pageToken != "" {
+			path += fmt.Sprintf("&page_token=%s", pageToken)
+		}
+
+		err := client.DoTenantRequest(ctx, GET, path, nil, response)
+		if err != nil {
+			tflog.Error(ctx, "Failed to get role member", map[string]interface{}{"error": err.Error()})
+			return nil, err
+		}
+		if response.Code != 0 {
+			tflog.Error(ctx, "API returned an error when getting role member", map[string]interface{}{"response": response})
+			return nil, fmt.Errorf("API error when getting role member: %s", response.Msg)
+		}
+
+		allMembers = append(allMembers, response.Data.Members...)
+
+		if !response.Data.HasMore || response.Data.PageToken == "" {
+			break
+		}
+		pageToken = response.Data.PageToken
 	}
-	tflog.Info(ctx, "Role Member Retrieved")
-	return response, nil
+
+	finalResponse := &RoleMemberGetResponse{
+		BaseResponse: BaseResponse{
+			Code: 0,
+			Msg:  "success",
+		},
+	}
+	finalResponse.Data.Members = allMembers
+
+	tflog.Info(ctx, "Role Member Retrieved", map[string]interface{}{"total_members": len(allMembers)})
+	return finalResponse, nil
 }
 
 // https://open.larksuite.com/document/server-docs/contact-v3/functional_role-member/batch_delete
@@ -832,12 +1365,16 @@ func DepartmentCreateAPI(ctx context.Context, client *LarkClient, request Depart
 	path := fmt.Sprintf("%s?department_id_type=open_department_id", DEPARTMENT_API)
 
 	err := client.DoTenantRequest(ctx, POST, path, request, response)
-	if err != nil || response.Code != 0 {
+	if err != nil {
 		tflog.Error(ctx, "Failed to create department", map[string]interface{}{
 			"error": err.Error(),
 		})
 		return nil, err
 	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when creating department", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when creating department: %s", response.Msg)
+	}
 
 	tflog.Info(ctx, "Department Create API Response Received")
 	return response, nil
@@ -850,12 +1387,16 @@ func DepartmentUpdateAPI(ctx context.Context, client *LarkClient, departmentID s
 	path := fmt.Sprintf("%s/%s?department_id_type=open_department_id", DEPARTMENT_API, departmentID)
 
 	err := client.DoTenantRequest(ctx, PUT, path, request, response)
-	if err != nil || response.Code != 0 {
+	if err != nil {
 		tflog.Error(ctx, "Failed to update department", map[string]interface{}{
 			"error": err.Error(),
 		})
 		return nil, err
 	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when updating department", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when updating department: %s", response.Msg)
+	}
 	tflog.Info(ctx, "Department Updated")
 	return response, nil
 }
@@ -876,12 +1417,16 @@ func DepartmentGetAPI(ctx context.Context, client *LarkClient, departmentID stri
 	path := fmt.Sprintf("%s/%s?department_id_type=%s", DEPARTMENT_API, departmentID, departmentIDType)
 
 	err := client.DoTenantRequest(ctx, GET, path, nil, response)
-	if err != nil || response.Code != 0 {
+	if err != nil {
 		tflog.Error(ctx, "Failed to get department", map[string]interface{}{
 			"error": err.Error(),
 		})
 		return nil, err
 	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when getting department", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when getting department: %w", &APIError{Code: response.Code, Msg: response.Msg})
+	}
 	tflog.Info(ctx, "Department Retrieved")
 	return response, nil
 }
@@ -893,12 +1438,16 @@ func DepartmentDeleteAPI(ctx context.Context, client *LarkClient, departmentID s
 
 	path := fmt.Sprintf("%s/%s", DEPARTMENT_API, departmentID)
 	err := client.DoTenantRequest(ctx, DELETE, path, nil, response)
-	if err != nil || response.Code != 0 {
+	if err != nil {
 		tflog.Error(ctx, "Failed to delete department", map[string]interface{}{
 			"error": err.Error(),
 		})
 		return nil, err
 	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when deleting department", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when deleting department: %s", response.Msg)
+	}
 	tflog.Info(ctx, "Department Deleted")
 	return response, nil
 }
@@ -920,6 +1469,50 @@ func DepartmentUpdateIDAPI(ctx context.Context, client *LarkClient, parentDepart
 	return response, nil
 }
 
+// https://open.larksuite.com/document/server-docs/contact-v3/department/children.
+// DepartmentChildrenListAPI pages through the child departments, and through every descendant when fetchChild is set.
+func DepartmentChildrenListAPI(ctx context.Context, client *LarkClient, departmentID string, departmentIDType DepartmentIDType, fetchChild bool) (*DepartmentListResponse, error) {
+	tflog.Info(ctx, "Listing Department Children", map[string]interface{}{"department_id": departmentID, "fetch_child": fetchChild})
+	var allDepartments []Department
+	pageToken := ""
+
+	for {
+		response := &DepartmentListResponse{}
+		path := fmt.Sprintf("%s/%s/children?department_id_type=%s&fetch_child=%t&page_size=50", DEPARTMENT_API, departmentID, departmentIDType, fetchChild)
+		if pageToken != "" {
+			path += fmt.Sprintf("&page_token=%s", pageToken)
+		}
+
+		err := client.DoTenantRequest(ctx, GET, path, nil, response)
+		if err != nil {
+			tflog.Error(ctx, "Failed to list department children", map[string]interface{}{"error": err.Error()})
+			return nil, err
+		}
+		if response.Code != 0 {
+			tflog.Error(ctx, "API returned an error when listing department children", map[string]interface{}{"response": response})
+			return nil, fmt.Errorf("API error when listing department children: %s", response.Msg)
+		}
+
+		allDepartments = append(allDepartments, response.Data.Items...)
+
+		if !response.Data.HasMore || response.Data.PageToken == "" {
+			break
+		}
+		pageToken = response.Data.PageToken
+	}
+
+	finalResponse := &DepartmentListResponse{
+		BaseResponse: BaseResponse{
+			Code: 0,
+			Msg:  "success",
+		},
+	}
+	finalResponse.Data.Items = allDepartments
+
+	tflog.Info(ctx, "Department Children Listed", map[string]interface{}{"total_departments": len(allDepartments)})
+	return finalResponse, nil
+}
+
 // WORKFORCE TYPE API.
 // https://open.larksuite.com/document/server-docs/contact-v3/employee_type_enum/create.
 func WorkforceTypeCreateAPI(ctx context.Context, client *LarkClient, request WorkforceTypeRequest) (*WorkforceTypeResponse, error) {
@@ -987,6 +1580,442 @@ func WorkforceTypeDeleteAPI(ctx context.Context, client *LarkClient, enumID stri
 	return response, nil
 }
 
+// JOB LEVEL API.
+// https://open.larksuite.com/document/server-docs/contact-v3/job_level/create.
+func JobLevelCreateAPI(ctx context.Context, client *LarkClient, request JobLevelRequest) (*JobLevelResponse, error) {
+	response := &JobLevelResponse{}
+	tflog.Info(ctx, "Creating Job Level", map[string]interface{}{"name": request.Name})
+
+	err := client.DoTenantRequest(ctx, POST, JOB_LEVEL_API, request, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to create job level", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when creating job level", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when creating job level: %s", response.Msg)
+	}
+
+	tflog.Info(ctx, "Job Level Created")
+	return response, nil
+}
+
+// https://open.larksuite.com/document/server-docs/contact-v3/job_level/get.
+func JobLevelGetAPI(ctx context.Context, client *LarkClient, jobLevelID string) (*JobLevelResponse, error) {
+	response := &JobLevelResponse{}
+	tflog.Info(ctx, "Getting Job Level", map[string]interface{}{"job_level_id": jobLevelID})
+	path := fmt.Sprintf("%s/%s", JOB_LEVEL_API, jobLevelID)
+
+	err := client.DoTenantRequest(ctx, GET, path, nil, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to get job level", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when getting job level", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when getting job level: %w", &APIError{Code: response.Code, Msg: response.Msg})
+	}
+
+	tflog.Info(ctx, "Job Level Retrieved")
+	return response, nil
+}
+
+// https://open.larksuite.com/document/server-docs/contact-v3/job_level/update.
+// The request replaces every field of the job level.
+func JobLevelUpdateAPI(ctx context.Context, client *LarkClient, jobLevelID string, request JobLevelRequest) (*JobLevelResponse, error) {
+	response := &JobLevelResponse{}
+	tflog.Info(ctx, "Updating Job Level", map[string]interface{}{"job_level_id": jobLevelID})
+	path := fmt.Sprintf("%s/%s", JOB_LEVEL_API, jobLevelID)
+
+	err := client.DoTenantRequest(ctx, PUT, path, request, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to update job level", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when updating job level", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when updating job level: %s", response.Msg)
+	}
+
+	tflog.Info(ctx, "Job Level Updated")
+	return response, nil
+}
+
+// https://open.larksuite.com/document/server-docs/contact-v3/job_level/delete.
+func JobLevelDeleteAPI(ctx context.Context, client *LarkClient, jobLevelID string) (*BaseResponse, error) {
+	response := &BaseResponse{}
+	tflog.Info(ctx, "Deleting Job Level", map[string]interface{}{"job_level_id": jobLevelID})
+	path := fmt.Sprintf("%s/%s", JOB_LEVEL_API, jobLevelID)
+
+	err := client.DoTenantRequest(ctx, DELETE, path, nil, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to delete job level", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when deleting job level", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when deleting job level: %s", response.Msg)
+	}
+
+	tflog.Info(ctx, "Job Level Deleted")
+	return response, nil
+}
+
+// JOB FAMILY API.
+// https://open.larksuite.com/document/server-docs/contact-v3/job_family/create.
+func JobFamilyCreateAPI(ctx context.Context, client *LarkClient, request JobFamilyRequest) (*JobFamilyResponse, error) {
+	response := &JobFamilyResponse{}
+	tflog.Info(ctx, "Creating Job Family", map[string]interface{}{"name": request.Name})
+
+	err := client.DoTenantRequest(ctx, POST, JOB_FAMILY_API, request, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to create job family", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when creating job family", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when creating job family: %s", response.Msg)
+	}
+
+	tflog.Info(ctx, "Job Family Created")
+	return response, nil
+}
+
+// https://open.larksuite.com/document/server-docs/contact-v3/job_family/get.
+func JobFamilyGetAPI(ctx context.Context, client *LarkClient, jobFamilyID string) (*JobFamilyResponse, error) {
+	response := &JobFamilyResponse{}
+	tflog.Info(ctx, "Getting Job Family", map[string]interface{}{"job_family_id": jobFamilyID})
+	path := fmt.Sprintf("%s/%s", JOB_FAMILY_API, jobFamilyID)
+
+	err := client.DoTenantRequest(ctx, GET, path, nil, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to get job family", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when getting job family", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when getting job family: %w", &APIError{Code: response.Code, Msg: response.Msg})
+	}
+
+	tflog.Info(ctx, "Job Family Retrieved")
+	return response, nil
+}
+
+// https://open.larksuite.com/document/server-docs/contact-v3/job_family/update.
+// The request replaces every field of the job family.
+func JobFamilyUpdateAPI(ctx context.Context, client *LarkClient, jobFamilyID string, request JobFamilyRequest) (*JobFamilyResponse, error) {
+	response := &JobFamilyResponse{}
+	tflog.Info(ctx, "Updating Job Family", map[string]interface{}{"job_family_id": jobFamilyID})
+	path := fmt.Sprintf("%s/%s", JOB_FAMILY_API, jobFamilyID)
+
+	err := client.DoTenantRequest(ctx, PUT, path, request, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to update job family", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when updating job family", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when updating job family: %s", response.Msg)
+	}
+
+	tflog.Info(ctx, "Job Family Updated")
+	return response, nil
+}
+
+// https://open.larksuite.com/document/server-docs/contact-v3/job_family/delete.
+func JobFamilyDeleteAPI(ctx context.Context, client *LarkClient, jobFamilyID string) (*BaseResponse, error) {
+	response := &BaseResponse{}
+	tflog.Info(ctx, "Deleting Job Family", map[string]interface{}{"job_family_id": jobFamilyID})
+	path := fmt.Sprintf("%s/%s", JOB_FAMILY_API, jobFamilyID)
+
+	err := client.DoTenantRequest(ctx, DELETE, path, nil, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to delete job family", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when deleting job family", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when deleting job family: %s", response.Msg)
+	}
+
+	tflog.Info(ctx, "Job Family Deleted")
+	return response, nil
+}
+
+// JOB TITLE API.
+// https://open.larksuite.com/document/server-docs/contact-v3/job_title/get.
+func JobTitleGetAPI(ctx context.Context, client *LarkClient, jobTitleID string) (*JobTitleGetResponse, error) {
+	response := &JobTitleGetResponse{}
+	tflog.Info(ctx, "Getting Job Title", map[string]interface{}{"job_title_id": jobTitleID})
+	path := fmt.Sprintf("%s/%s", JOB_TITLE_API, jobTitleID)
+
+	err := client.DoTenantRequest(ctx, GET, path, nil, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to get job title", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when getting job title", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when getting job title: %s", response.Msg)
+	}
+
+	tflog.Info(ctx, "Job Title Retrieved")
+	return response, nil
+}
+
+// https://open.larksuite.com/document/server-docs/contact-v3/job_title/list.
+// JobTitleListAPI pages through every job title of the tenant.
+func JobTitleListAPI(ctx context.Context, client *LarkClient) (*JobTitleListResponse, error) {
+	tflog.Info(ctx, "Listing Job Titles")
+	var allJobTitles []JobTitle
+	pageToken := ""
+
+	for {
+		response := &JobTitleListResponse{}
+		path := fmt.Sprintf("%s?page_size=100", JOB_TITLE_API)
+		if pageToken != "" {
+			path += fmt.Sprintf("&page_token=%s", pageToken)
+		}
+
+		err := client.DoTenantRequest(ctx, GET, path, nil, response)
+		if err != nil {
+			tflog.Error(ctx, "Failed to list job titles", map[string]interface{}{"error": err.Error()})
+			return nil, err
+		}
+		if response.Code != 0 {
+			tflog.Error(ctx, "API returned an error when listing job titles", map[string]interface{}{"response": response})
+			return nil, fmt.Errorf("API error when listing job titles: %s", response.Msg)
+		}
+
+		allJobTitles = append(allJobTitles, response.Data.Items...)
+
+		if !response.Data.HasMore || response.Data.PageToken == "" {
+			break
+		}
+		pageToken = response.Data.PageToken
+	}
+
+	finalResponse := &JobTitleListResponse{
+		BaseResponse: BaseResponse{
+			Code: 0,
+			Msg:  "success",
+		},
+	}
+	finalResponse.Data.Items = allJobTitles
+
+	tflog.Info(ctx, "Job Titles Listed", map[string]interface{}{"total_job_titles": len(allJobTitles)})
+	return finalResponse, nil
+}
+
+// UNIT API.
+// https://open.larksuite.com/document/server-docs/contact-v3/unit/create.
+func UnitCreateAPI(ctx context.Context, client *LarkClient, request UnitCreateRequest) (*UnitCreateResponse, error) {
+	response := &UnitCreateResponse{}
+	tflog.Info(ctx, "Creating Unit", map[string]interface{}{"name": request.Name})
+
+	err := client.DoTenantRequest(ctx, POST, UNIT_API, request, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to create unit", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when creating unit", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when creating unit: %s", response.Msg)
+	}
+
+	tflog.Info(ctx, "Unit Created")
+	return response, nil
+}
+
+// https://open.larksuite.com/document/server-docs/contact-v3/unit/get.
+func UnitGetAPI(ctx context.Context, client *LarkClient, unitID string) (*UnitGetResponse, error) {
+	response := &UnitGetResponse{}
+	tflog.Info(ctx, "Getting Unit", map[string]interface{}{"unit_id": unitID})
+	path := fmt.Sprintf("%s/%s", UNIT_API, unitID)
+
+	err := client.DoTenantRequest(ctx, GET, path, nil, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to get unit", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when getting unit", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when getting unit: %w", &APIError{Code: response.Code, Msg: response.Msg})
+	}
+
+	tflog.Info(ctx, "Unit Retrieved")
+	return response, nil
+}
+
+// https://open.larksuite.com/document/server-docs/contact-v3/unit/list.
+// UnitListAPI pages through every unit of the tenant.
+func UnitListAPI(ctx context.Context, client *LarkClient) (*UnitListResponse, error) {
+	tflog.Info(ctx, "Listing Units")
+	var allUnits []Unit
+	pageToken := ""
+
+	for {
+		response := &UnitListResponse{}
+		path := fmt.Sprintf("%s?page_size=100", UNIT_API)
+		if pageToken != "" {
+			path += fmt.Sprintf("&page_token=%s", pageToken)
+		}
+
+		err := client.DoTenantRequest(ctx, GET, path, nil, response)
+		if err != nil {
+			tflog.Error(ctx, "Failed to list units", map[string]interface{}{"error": err.Error()})
+			return nil, err
+		}
+		if response.Code != 0 {
+			tflog.Error(ctx, "API returned an error when listing units", map[string]interface{}{"response": response})
+			return nil, fmt.Errorf("API error when listing units: %s", response.Msg)
+		}
+
+		allUnits = append(allUnits, response.Data.UnitList...)
+
+		if !response.Data.HasMore || response.Data.PageToken == "" {
+			break
+		}
+		pageToken = response.Data.PageToken
+	}
+
+	finalResponse := &UnitListResponse{
+		BaseResponse: BaseResponse{
+			Code: 0,
+			Msg:  "success",
+		},
+	}
+	finalResponse.Data.UnitList = allUnits
+
+	tflog.Info(ctx, "Units Listed", map[string]interface{}{"total_units": len(allUnits)})
+	return finalResponse, nil
+}
+
+// https://open.larksuite.com/document/server-docs/contact-v3/unit/patch.
+// Only the name of a unit can be updated.
+func UnitUpdateAPI(ctx context.Context, client *LarkClient, unitID string, request UnitUpdateRequest) (*BaseResponse, error) {
+	response := &BaseResponse{}
+	tflog.Info(ctx, "Updating Unit", map[string]interface{}{"unit_id": unitID})
+	path := fmt.Sprintf("%s/%s", UNIT_API, unitID)
+
+	err := client.DoTenantRequest(ctx, PATCH, path, request, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to update unit", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when updating unit", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when updating unit: %s", response.Msg)
+	}
+
+	tflog.Info(ctx, "Unit Updated")
+	return response, nil
+}
+
+// https://open.larksuite.com/document/server-docs/contact-v3/unit/delete.
+func UnitDeleteAPI(ctx context.Context, client *LarkClient, unitID string) (*BaseResponse, error) {
+	response := &BaseResponse{}
+	tflog.Info(ctx, "Deleting Unit", map[string]interface{}{"unit_id": unitID})
+	path := fmt.Sprintf("%s/%s", UNIT_API, unitID)
+
+	err := client.DoTenantRequest(ctx, DELETE, path, nil, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to delete unit", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when deleting unit", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when deleting unit: %s", response.Msg)
+	}
+
+	tflog.Info(ctx, "Unit Deleted")
+	return response, nil
+}
+
+// https://open.larksuite.com/document/server-docs/contact-v3/unit/bind_department.
+func UnitBindDepartmentAPI(ctx context.Context, client *LarkClient, request UnitDepartmentRequest) (*BaseResponse, error) {
+	response := &BaseResponse{}
+	tflog.Info(ctx, "Binding Department to Unit", map[string]interface{}{"unit_id": request.UnitID, "department_id": request.DepartmentID})
+	path := fmt.Sprintf("%s/bind_department", UNIT_API)
+
+	err := client.DoTenantRequest(ctx, POST, path, request, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to bind department to unit", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when binding department to unit", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when binding department to unit: %s", response.Msg)
+	}
+
+	tflog.Info(ctx, "Department Bound to Unit")
+	return response, nil
+}
+
+// https://open.larksuite.com/document/server-docs/contact-v3/unit/unbind_department.
+func UnitUnbindDepartmentAPI(ctx context.Context, client *LarkClient, request UnitDepartmentRequest) (*BaseResponse, error) {
+	response := &BaseResponse{}
+	tflog.Info(ctx, "Unbinding Department from Unit", map[string]interface{}{"unit_id": request.UnitID, "department_id": request.DepartmentID})
+	path := fmt.Sprintf("%s/unbind_department", UNIT_API)
+
+	err := client.DoTenantRequest(ctx, POST, path, request, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to unbind department from unit", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when unbinding department from unit", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when unbinding department from unit: %s", response.Msg)
+	}
+
+	tflog.Info(ctx, "Department Unbound from Unit")
+	return response, nil
+}
+
+// https://open.larksuite.com/document/server-docs/contact-v3/unit/list_department.
+// UnitDepartmentListAPI pages through every department bound to the unit.
+func UnitDepartmentListAPI(ctx context.Context, client *LarkClient, unitID string, departmentIDType DepartmentIDType) (*UnitDepartmentListResponse, error) {
+	tflog.Info(ctx, "Listing Unit Departments", map[string]interface{}{"unit_id": unitID})
+	var allDepartments []UnitDepartment
+	pageToken := ""
+
+	for {
+		response := &UnitDepartmentListResponse{}
+		path := fmt.Sprintf("%s/list_department?unit_id=%s&department_id_type=%s&page_size=100", UNIT_API, unitID, departmentIDType)
+		if pageToken != "" {
+			path += fmt.Sprintf("&page_token=%s", pageToken)
+		}
+
+		err := client.DoTenantRequest(ctx, GET, path, nil, response)
+		if err != nil {
+			tflog.Error(ctx, "Failed to list unit departments", map[string]interface{}{"error": err.Error()})
+			return nil, err
+		}
+		if response.Code != 0 {
+			tflog.Error(ctx, "API returned an error when listing unit departments", map[string]interface{}{"response": response})
+			return nil, fmt.Errorf("API error when listing unit departments: %s", response.Msg)
+		}
+
+		allDepartments = append(allDepartments, response.Data.DepartmentList...)
+
+		if !response.Data.HasMore || response.Data.PageToken == "" {
+			break
+		}
+		pageToken = response.Data.PageToken
+	}
+
+	finalResponse := &UnitDepartmentListResponse{
+		BaseResponse: BaseResponse{
+			Code: 0,
+			Msg:  "success",
+		},
+	}
+	finalResponse.Data.DepartmentList = allDepartments
+
+	tflog.Info(ctx, "Unit Departments Listed", map[string]interface{}{"total_departments": len(allDepartments)})
+	return finalResponse, nil
+}
+
 // DOCS SPACE API.
 
 // DOCS SPACE FOLDER API.
@@ -1147,3 +2176,190 @@ func FileDeleteAPI(ctx context.Context, client *LarkClient, fileToken, fileType
 	tflog.Info(ctx, "File Delete task created successfully", map[string]interface{}{"task_id": response.Data.TaskID})
 	return response, nil
 }
+
+// IM IMAGE API.
+// https://open.larksuite.com/document/server-docs/im-v1/image/create.
+func ImageUploadAPI(ctx context.Context, client *LarkClient, imageType string, fileName string, image []byte) (*ImageUploadResponse, error) {
+	response := &ImageUploadResponse{}
+	tflog.Info(ctx, "Uploading Image", map[string]interface{}{
+		"image_type": imageType,
+		"file_name":  fileName,
+	})
+
+	request := &MultipartRequest{
+		Fields: map[string]string{
+			"image_type": imageType,
+		},
+		FileField: "image",
+		FileName:  fileName,
+		File:      image,
+	}
+
+	err := client.DoTenantRequest(ctx, POST, IM_IMAGE_API, request, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to upload image", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when uploading image", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when uploading image: %s", response.Msg)
+	}
+
+	tflog.Info(ctx, "Image Uploaded", map[string]interface{}{"image_key": response.Data.ImageKey})
+	return response, nil
+}
+
+// IM MESSAGE API.
+// https://open.larksuite.com/document/server-docs/im-v1/message/get.
+func MessageGetAPI(ctx context.Context, client *LarkClient, messageID string) (*MessageGetResponse, error) {
+	response := &MessageGetResponse{}
+	tflog.Info(ctx, "Getting Message", map[string]interface{}{"message_id": messageID})
+	path := fmt.Sprintf("%s/%s", IM_MESSAGE_API, messageID)
+
+	err := client.DoTenantRequest(ctx, GET, path, nil, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to get message", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when getting message", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when getting message: %w", &APIError{Code: response.Code, Msg: response.Msg})
+	}
+
+	tflog.Info(ctx, "Message Retrieved", map[string]interface{}{"total_items": len(response.Data.Items)})
+	return response, nil
+}
+
+// https://open.larksuite.com/document/server-docs/im-v1/message/create.
+// The UUID is set before sending, so a retry in DoRequest after a lost response doesn't send the message twice.
+func MessageSendAPI(ctx context.Context, client *LarkClient, receiveIDType string, request MessageSendRequest) (*MessageResponse, error) {
+	response := &MessageResponse{}
+	if request.UUID == "" {
+		uuid, err := NewUUID()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate message UUID: %w", err)
+		}
+		request.UUID = uuid
+	}
+	tflog.Info(ctx, "Sending Message", map[string]interface{}{
+		"receive_id_type": receiveIDType,
+		"msg_type":        request.MsgType,
+		"uuid":            request.UUID,
+	})
+	path := fmt.Sprintf("%s?receive_id_type=%s", IM_MESSAGE_API, receiveIDType)
+
+	err := client.DoTenantRequest(ctx, POST, path, request, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to send message", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when sending message", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when sending message: %s", response.Msg)
+	}
+
+	tflog.Info(ctx, "Message Sent", map[string]interface{}{"message_id": response.Data.MessageID})
+	return response, nil
+}
+
+// https://open.larksuite.com/document/server-docs/im-v1/message/update.
+// Only text and post messages can be edited.
+func MessageUpdateAPI(ctx context.Context, client *LarkClient, messageID string, request MessageUpdateRequest) (*BaseResponse, error) {
+	response := &BaseResponse{}
+	tflog.Info(ctx, "Updating Message", map[string]interface{}{"message_id": messageID})
+	path := fmt.Sprintf("%s/%s", IM_MESSAGE_API, messageID)
+
+	err := client.DoTenantRequest(ctx, PUT, path, request, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to update message", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when updating message", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when updating message: %s", response.Msg)
+	}
+
+	tflog.Info(ctx, "Message Updated")
+	return response, nil
+}
+
+// https://open.larksuite.com/document/server-docs/im-v1/message-card/patch.
+// Only interactive cards sent with update_multi set to true can be patched.
+func MessageCardPatchAPI(ctx context.Context, client *LarkClient, messageID string, request MessageUpdateRequest) (*BaseResponse, error) {
+	response := &BaseResponse{}
+	tflog.Info(ctx, "Patching Message Card", map[string]interface{}{"message_id": messageID})
+	path := fmt.Sprintf("%s/%s", IM_MESSAGE_API, messageID)
+
+	err := client.DoTenantRequest(ctx, PATCH, path, request, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to patch message card", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when patching message card", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when patching message card: %s", response.Msg)
+	}
+
+	tflog.Info(ctx, "Message Card Patched")
+	return response, nil
+}
+
+// https://open.larksuite.com/document/server-docs/im-v1/message/delete.
+func MessageRecallAPI(ctx context.Context, client *LarkClient, messageID string) (*BaseResponse, error) {
+	response := &BaseResponse{}
+	tflog.Info(ctx, "Recalling Message", map[string]interface{}{"message_id": messageID})
+	path := fmt.Sprintf("%s/%s", IM_MESSAGE_API, messageID)
+
+	err := client.DoTenantRequest(ctx, DELETE, path, nil, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to recall message", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when recalling message", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when recalling message: %s", response.Msg)
+	}
+
+	tflog.Info(ctx, "Message Recalled")
+	return response, nil
+}
+
+// IM PIN API.
+// https://open.larksuite.com/document/server-docs/im-v1/pin/create.
+func MessagePinAPI(ctx context.Context, client *LarkClient, messageID string) (*BaseResponse, error) {
+	response := &BaseResponse{}
+	tflog.Info(ctx, "Pinning Message", map[string]interface{}{"message_id": messageID})
+
+	err := client.DoTenantRequest(ctx, POST, IM_PIN_API, MessagePinRequest{MessageID: messageID}, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to pin message", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when pinning message", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when pinning message: %s", response.Msg)
+	}
+
+	tflog.Info(ctx, "Message Pinned")
+	return response, nil
+}
+
+// https://open.larksuite.com/document/server-docs/im-v1/pin/delete.
+func MessageUnpinAPI(ctx context.Context, client *LarkClient, messageID string) (*BaseResponse, error) {
+	response := &BaseResponse{}
+	tflog.Info(ctx, "Unpinning Message", map[string]interface{}{"message_id": messageID})
+	path := fmt.Sprintf("%s/%s", IM_PIN_API, messageID)
+
+	err := client.DoTenantRequest(ctx, DELETE, path, nil, response)
+	if err != nil {
+		tflog.Error(ctx, "Failed to unpin message", map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if response.Code != 0 {
+		tflog.Error(ctx, "API returned an error when unpinning message", map[string]interface{}{"response": response})
+		return nil, fmt.Errorf("API error when unpinning message: %s", response.Msg)
+	}
+
+	tflog.Info(ctx, "Message Unpinned")
+	return response, nil
+}
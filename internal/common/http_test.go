@@ -392,55 +392,61 @@ func TestUsergroupMemberAddAPI(t *testing.T) {
 }
 
 func TestUsergroupMemberGetByMemberTypeAPI(t *testing.T) {
+	firstPage := UsergroupMemberGetResponse{}
+	firstPage.Data.MemberList = []UsergroupMember{{MemberID: "ou_1", MemberType: "user", MemberIDType: "open_id"}}
+	firstPage.Data.PageToken = "next_page"
+	firstPage.Data.HasMore = true
+	secondPage := UsergroupMemberGetResponse{}
+	secondPage.Data.MemberList = []UsergroupMember{{MemberID: "ou_2", MemberType: "user", MemberIDType: "open_id"}}
+
 	tests := []struct {
-		name         string
-		groupID      string
-		memberType   string
-		mockResponse UsergroupMemberGetResponse
-		mockError    error
-		wantErr      bool
+		name        string
+		responses   []UsergroupMemberGetResponse
+		wantErr     bool
+		wantMembers []UsergroupMember
 	}{
 		{
-			name:         "error on get by member type",
-			groupID:      "group1",
-			memberType:   "user",
-			mockResponse: UsergroupMemberGetResponse{},
-			mockError:    fmt.Errorf("get member failed"),
-			wantErr:      true,
+			name:        "success with multiple pages",
+			responses:   []UsergroupMemberGetResponse{firstPage, secondPage},
+			wantErr:     false,
+			wantMembers: append(firstPage.Data.MemberList, secondPage.Data.MemberList...),
 		},
 		{
-			name:         "success get by member type",
-			groupID:      "group1",
-			memberType:   "user",
-			mockResponse: UsergroupMemberGetResponse{},
-			mockError:    nil,
-			wantErr:      false,
+			name:      "error on second page",
+			responses: []UsergroupMemberGetResponse{firstPage},
+			wantErr:   true,
+		},
+		{
+			name: "error response code",
+			responses: []UsergroupMemberGetResponse{
+				{BaseResponse: BaseResponse{Code: 42001, Msg: "group not found"}},
+			},
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
 		PatchConvey(tt.name, t, func() {
+			paths := []string{}
 			Mock((*LarkClient).DoTenantRequest).To(func(c *LarkClient, ctx context.Context, method HTTPMethod, path string, reqBody interface{}, resp interface{}) error {
-				if tt.mockError != nil {
-					return tt.mockError
-				}
-				if r, ok := resp.(*UsergroupMemberGetResponse); ok {
-					*r = tt.mockResponse
-				} else {
-					return fmt.Errorf("unexpected type for response")
+				if len(paths) >= len(tt.responses) {
+					return fmt.Errorf("error on page %d", len(paths)+1)
 				}
+				*resp.(*UsergroupMemberGetResponse) = tt.responses[len(paths)]
+				paths = append(paths, path)
 				return nil
 			}).Build()
 
 			client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
-			got, err := UsergroupMemberGetByMemberTypeAPI(context.Background(), client, tt.groupID, tt.memberType)
+			got, err := UsergroupMemberGetByMemberTypeAPI(context.Background(), client, "group1", "user")
+			So(paths[0], ShouldEqual, USERGROUP_API+"/group1/member/simplelist?member_type=user&page_size=100")
 			if tt.wantErr {
 				So(err, ShouldNotBeNil)
 				So(got, ShouldBeNil)
 			} else {
 				So(err, ShouldBeNil)
-				So(got, ShouldResemble, &tt.mockResponse)
+				So(paths[1], ShouldEqual, USERGROUP_API+"/group1/member/simplelist?member_type=user&page_size=100&page_token=next_page")
+				So(got.Data.MemberList, ShouldResemble, tt.wantMembers)
 			}
-			UnPatchAll()
 		})
 	}
 }
@@ -1505,46 +1511,61 @@ func TestRoleMemberAddAPI(t *testing.T) {
 }
 
 func TestRoleMemberGetAPI(t *testing.T) {
+	firstPage := RoleMemberGetResponse{}
+	firstPage.Data.Members = []RoleMember{{UserID: "ou_1", ScopeID: "scope1"}}
+	firstPage.Data.PageToken = "next_page"
+	firstPage.Data.HasMore = true
+	secondPage := RoleMemberGetResponse{}
+	secondPage.Data.Members = []RoleMember{{UserID: "ou_2", ScopeID: "scope1"}}
+
 	tests := []struct {
-		name    string
-		mockFn  func() []*MockBuilder
-		wantErr bool
+		name        string
+		responses   []RoleMemberGetResponse
+		wantErr     bool
+		wantMembers []RoleMember
 	}{
 		{
-			name: "success get",
-			mockFn: func() []*MockBuilder {
-				return []*MockBuilder{
-					Mock((*LarkClient).DoTenantRequest).To(func(c *LarkClient, ctx context.Context, method HTTPMethod, path string, reqBody interface{}, resp interface{}) error {
-						return nil
-					}),
-				}
-			},
+			name:        "success with multiple pages",
+			responses:   []RoleMemberGetResponse{firstPage, secondPage},
+			wantErr:     false,
+			wantMembers: append(firstPage.Data.Members, secondPage.Data.Members...),
 		},
 		{
-			name: "error on get",
-			mockFn: func() []*MockBuilder {
-				return []*MockBuilder{
-					Mock((*LarkClient).DoTenantRequest).To(func(c *LarkClient, ctx context.Context, method HTTPMethod, path string, reqBody interface{}, resp interface{}) error {
-						return fmt.Errorf("get failed")
-					}),
-				}
+			name:      "error on second page",
+			responses: []RoleMemberGetResponse{firstPage},
+			wantErr:   true,
+		},
+		{
+			name: "error response code",
+			responses: []RoleMemberGetResponse{
+				{BaseResponse: BaseResponse{Code: 40001, Msg: "role not found"}},
 			},
 			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
 		PatchConvey(tt.name, t, func() {
-			for _, mockBuilder := range tt.mockFn() {
-				mockBuilder.Build()
-			}
+			paths := []string{}
+			Mock((*LarkClient).DoTenantRequest).To(func(c *LarkClient, ctx context.Context, method HTTPMethod, path string, reqBody interface{}, resp interface{}) error {
+				if len(paths) >= len(tt.responses) {
+					return fmt.Errorf("error on page %d", len(paths)+1)
+				}
+				*resp.(*RoleMemberGetResponse) = tt.responses[len(paths)]
+				paths = append(paths, path)
+				return nil
+			}).Build()
 
 			client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
 			got, err := RoleMemberGetAPI(context.Background(), client, "role1")
+			So(paths[0], ShouldEqual, ROLE_API+"/role1/members?page_size=100")
 			if tt.wantErr {
 				So(err, ShouldNotBeNil)
 				So(got, ShouldBeNil)
+			} else {
+				So(err, ShouldBeNil)
+				So(paths[1], ShouldEqual, ROLE_API+"/role1/members?page_size=100&page_token=next_page")
+				So(got.Data.Members, ShouldResemble, tt.wantMembers)
 			}
-			UnPatchAll()
 		})
 	}
 }
@@ -1623,6 +1644,19 @@ func TestDepartmentCreateAPI(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "error response code",
+			mockFn: func() []*MockBuilder {
+				return []*MockBuilder{
+					Mock((*LarkClient).DoTenantRequest).To(func(c *LarkClient, ctx context.Context, method HTTPMethod, path string, reqBody interface{}, resp interface{}) error {
+						resp.(*DepartmentGetResponse).Code = 40003
+						resp.(*DepartmentGetResponse).Msg = "department not found"
+						return nil
+					}),
+				}
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		PatchConvey(tt.name, t, func() {
@@ -1669,6 +1703,19 @@ func TestDepartmentUpdateAPI(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "error response code",
+			mockFn: func() []*MockBuilder {
+				return []*MockBuilder{
+					Mock((*LarkClient).DoTenantRequest).To(func(c *LarkClient, ctx context.Context, method HTTPMethod, path string, reqBody interface{}, resp interface{}) error {
+						resp.(*DepartmentGetResponse).Code = 40003
+						resp.(*DepartmentGetResponse).Msg = "department not found"
+						return nil
+					}),
+				}
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		PatchConvey(tt.name, t, func() {
@@ -1715,6 +1762,19 @@ func TestDepartmentDeleteAPI(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "error response code",
+			mockFn: func() []*MockBuilder {
+				return []*MockBuilder{
+					Mock((*LarkClient).DoTenantRequest).To(func(c *LarkClient, ctx context.Context, method HTTPMethod, path string, reqBody interface{}, resp interface{}) error {
+						resp.(*DepartmentDeleteResponse).Code = 40003
+						resp.(*DepartmentDeleteResponse).Msg = "department not found"
+						return nil
+					}),
+				}
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		PatchConvey(tt.name, t, func() {
@@ -1761,6 +1821,19 @@ func TestDepartmentGetAPI(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "error response code",
+			mockFn: func() []*MockBuilder {
+				return []*MockBuilder{
+					Mock((*LarkClient).DoTenantRequest).To(func(c *LarkClient, ctx context.Context, method HTTPMethod, path string, reqBody interface{}, resp interface{}) error {
+						resp.(*DepartmentGetResponse).Code = 40003
+						resp.(*DepartmentGetResponse).Msg = "department not found"
+						return nil
+					}),
+				}
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		PatchConvey(tt.name, t, func() {
@@ -1779,6 +1852,66 @@ func TestDepartmentGetAPI(t *testing.T) {
 	}
 }
 
+func TestDepartmentChildrenListAPI(t *testing.T) {
+	firstPage := DepartmentListResponse{}
+	firstPage.Data.Items = []Department{{OpenDepartmentID: "od-1"}}
+	firstPage.Data.PageToken = "next_page"
+	firstPage.Data.HasMore = true
+	secondPage := DepartmentListResponse{}
+	secondPage.Data.Items = []Department{{OpenDepartmentID: "od-2"}}
+
+	tests := []struct {
+		name            string
+		responses       []DepartmentListResponse
+		wantErr         bool
+		wantDepartments []Department
+	}{
+		{
+			name:            "success with multiple pages",
+			responses:       []DepartmentListResponse{firstPage, secondPage},
+			wantErr:         false,
+			wantDepartments: append(firstPage.Data.Items, secondPage.Data.Items...),
+		},
+		{
+			name:      "error on second page",
+			responses: []DepartmentListResponse{firstPage},
+			wantErr:   true,
+		},
+		{
+			name: "error response code",
+			responses: []DepartmentListResponse{
+				{BaseResponse: BaseResponse{Code: 40003, Msg: "department not found"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		PatchConvey(tt.name, t, func() {
+			paths := []string{}
+			Mock((*LarkClient).DoTenantRequest).To(func(c *LarkClient, ctx context.Context, method HTTPMethod, path string, reqBody interface{}, resp interface{}) error {
+				if len(paths) >= len(tt.responses) {
+					return fmt.Errorf("error on page %d", len(paths)+1)
+				}
+				*resp.(*DepartmentListResponse) = tt.responses[len(paths)]
+				paths = append(paths, path)
+				return nil
+			}).Build()
+
+			client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+			got, err := DepartmentChildrenListAPI(context.Background(), client, "od-0", OPEN_DEPARTMENT_ID, true)
+			So(paths[0], ShouldEqual, DEPARTMENT_API+"/od-0/children?department_id_type=open_department_id&fetch_child=true&page_size=50")
+			if tt.wantErr {
+				So(err, ShouldNotBeNil)
+				So(got, ShouldBeNil)
+			} else {
+				So(err, ShouldBeNil)
+				So(paths[1], ShouldEndWith, "&page_token=next_page")
+				So(got.Data.Items, ShouldResemble, tt.wantDepartments)
+			}
+		})
+	}
+}
+
 func TestWorkforceTypeCreateAPI(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -2350,3 +2483,1563 @@ func TestFileDeleteAPI(t *testing.T) {
 		})
 	}
 }
+
+func TestImageUploadAPI(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockError    error
+		mockResponse ImageUploadResponse
+		wantErr      bool
+	}{
+		{
+			name: "success upload",
+			mockResponse: ImageUploadResponse{
+				Data: struct {
+					ImageKey string `json:"image_key"`
+				}{
+					ImageKey: "img_v2_test",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:      "error on upload",
+			mockError: fmt.Errorf("upload failed"),
+			wantErr:   true,
+		},
+		{
+			name: "error response code",
+			mockResponse: ImageUploadResponse{
+				BaseResponse: BaseResponse{Code: 234001, Msg: "invalid image"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		PatchConvey(tt.name, t, func() {
+			cleanup := SetupDoTenantRequest(tt.mockError, tt.mockResponse)
+			defer cleanup()
+
+			client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+			got, err := ImageUploadAPI(context.Background(), client, "avatar", "avatar.png", []byte("image"))
+			if tt.wantErr {
+				So(err, ShouldNotBeNil)
+				So(got, ShouldBeNil)
+			} else {
+				So(err, ShouldBeNil)
+				So(got.Data.ImageKey, ShouldEqual, "img_v2_test")
+			}
+		})
+	}
+}
+
+func TestGroupChatModerationGetAPI(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockError    error
+		mockResponse GroupChatModerationGetResponse
+		wantErr      bool
+	}{
+		{
+			name: "success get",
+			mockResponse: GroupChatModerationGetResponse{
+				Data: struct {
+					ModerationSetting string               `json:"moderation_setting"`
+					PageToken         string               `json:"page_token"`
+					HasMore           bool                 `json:"has_more"`
+					Items             []GroupChatModerator `json:"items"`
+				}{
+					ModerationSetting: "moderator_list",
+					Items: []GroupChatModerator{
+						{UserIDType: "open_id", UserID: "ou_1"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:      "error on get",
+			mockError: fmt.Errorf("get failed"),
+			wantErr:   true,
+		},
+		{
+			name: "error response code",
+			mockResponse: GroupChatModerationGetResponse{
+				BaseResponse: BaseResponse{Code: 232011, Msg: "chat not found"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		PatchConvey(tt.name, t, func() {
+			cleanup := SetupDoTenantRequest(tt.mockError, tt.mockResponse)
+			defer cleanup()
+
+			client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+			got, err := GroupChatModerationGetAPI(context.Background(), client, "oc_1")
+			if tt.wantErr {
+				So(err, ShouldNotBeNil)
+				So(got, ShouldBeNil)
+			} else {
+				So(err, ShouldBeNil)
+				So(got.Data.ModerationSetting, ShouldEqual, "moderator_list")
+				So(got.Data.Items, ShouldResemble, tt.mockResponse.Data.Items)
+			}
+		})
+	}
+}
+
+func TestGroupChatSpeechScopesUpdateAPI(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockError    error
+		mockResponse BaseResponse
+		wantErr      bool
+	}{
+		{
+			name:    "success update",
+			wantErr: false,
+		},
+		{
+			name:      "error on update",
+			mockError: fmt.Errorf("update failed"),
+			wantErr:   true,
+		},
+		{
+			name:         "error response code",
+			mockResponse: BaseResponse{Code: 232011, Msg: "chat not found"},
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		PatchConvey(tt.name, t, func() {
+			cleanup := SetupDoTenantRequest(tt.mockError, tt.mockResponse)
+			defer cleanup()
+
+			client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+			got, err := GroupChatSpeechScopesUpdateAPI(context.Background(), client, "oc_1", GroupChatSpeechScopesUpdateRequest{
+				ModerationSetting: "only_owner",
+			})
+			if tt.wantErr {
+				So(err, ShouldNotBeNil)
+				So(got, ShouldBeNil)
+			} else {
+				So(err, ShouldBeNil)
+			}
+		})
+	}
+}
+
+func TestGroupChatAnnouncementGetAPI(t *testing.T) {
+	successResponse := GroupChatAnnouncementGetResponse{}
+	successResponse.Data.Revision = "2"
+
+	tests := []struct {
+		name         string
+		mockError    error
+		mockResponse GroupChatAnnouncementGetResponse
+		wantErr      bool
+	}{
+		{
+			name:         "success get",
+			mockResponse: successResponse,
+			wantErr:      false,
+		},
+		{
+			name:      "error on get",
+			mockError: fmt.Errorf("get failed"),
+			wantErr:   true,
+		},
+		{
+			name: "error response code",
+			mockResponse: GroupChatAnnouncementGetResponse{
+				BaseResponse: BaseResponse{Code: 232011, Msg: "chat not found"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		PatchConvey(tt.name, t, func() {
+			cleanup := SetupDoTenantRequest(tt.mockError, tt.mockResponse)
+			defer cleanup()
+
+			client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+			got, err := GroupChatAnnouncementGetAPI(context.Background(), client, "oc_1")
+			if tt.wantErr {
+				So(err, ShouldNotBeNil)
+				So(got, ShouldBeNil)
+			} else {
+				So(err, ShouldBeNil)
+				So(got.Data.Revision, ShouldEqual, "2")
+			}
+		})
+	}
+}
+
+func TestGroupChatAnnouncementUpdateAPI(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockError    error
+		mockResponse BaseResponse
+		wantErr      bool
+	}{
+		{
+			name:    "success update",
+			wantErr: false,
+		},
+		{
+			name:      "error on update",
+			mockError: fmt.Errorf("update failed"),
+			wantErr:   true,
+		},
+		{
+			name:         "error response code",
+			mockResponse: BaseResponse{Code: 232097, Msg: "revision conflict"},
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		PatchConvey(tt.name, t, func() {
+			cleanup := SetupDoTenantRequest(tt.mockError, tt.mockResponse)
+			defer cleanup()
+
+			client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+			got, err := GroupChatAnnouncementUpdateAPI(context.Background(), client, "oc_1", GroupChatAnnouncementUpdateRequest{
+				Revision: "2",
+			})
+			if tt.wantErr {
+				So(err, ShouldNotBeNil)
+				So(got, ShouldBeNil)
+			} else {
+				So(err, ShouldBeNil)
+			}
+		})
+	}
+}
+
+func TestGroupChatTabAPI(t *testing.T) {
+	client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+	apis := map[string]func() (*GroupChatTabsResponse, error){
+		"list": func() (*GroupChatTabsResponse, error) {
+			return GroupChatTabListAPI(context.Background(), client, "oc_1")
+		},
+		"create": func() (*GroupChatTabsResponse, error) {
+			return GroupChatTabCreateAPI(context.Background(), client, "oc_1", GroupChatTabsRequest{})
+		},
+		"update": func() (*GroupChatTabsResponse, error) {
+			return GroupChatTabUpdateAPI(context.Background(), client, "oc_1", GroupChatTabsRequest{})
+		},
+		"delete": func() (*GroupChatTabsResponse, error) {
+			return GroupChatTabDeleteAPI(context.Background(), client, "oc_1", GroupChatTabIDsRequest{})
+		},
+		"sort": func() (*GroupChatTabsResponse, error) {
+			return GroupChatTabSortAPI(context.Background(), client, "oc_1", GroupChatTabIDsRequest{})
+		},
+	}
+
+	successResponse := GroupChatTabsResponse{}
+	successResponse.Data.ChatTabs = []GroupChatTab{{TabID: "7101214603622940633", TabName: "runbook", TabType: "doc"}}
+
+	tests := []struct {
+		name         string
+		mockError    error
+		mockResponse GroupChatTabsResponse
+		wantErr      bool
+	}{
+		{
+			name:         "success",
+			mockResponse: successResponse,
+			wantErr:      false,
+		},
+		{
+			name:      "error on request",
+			mockError: fmt.Errorf("request failed"),
+			wantErr:   true,
+		},
+		{
+			name: "error response code",
+			mockResponse: GroupChatTabsResponse{
+				BaseResponse: BaseResponse{Code: 232011, Msg: "chat not found"},
+			},
+			wantErr: true,
+		},
+	}
+	for api, call := range apis {
+		for _, tt := range tests {
+			PatchConvey(fmt.Sprintf("%s: %s", api, tt.name), t, func() {
+				cleanup := SetupDoTenantRequest(tt.mockError, tt.mockResponse)
+				defer cleanup()
+
+				got, err := call()
+				if tt.wantErr {
+					So(err, ShouldNotBeNil)
+					So(got, ShouldBeNil)
+				} else {
+					So(err, ShouldBeNil)
+					So(got.Data.ChatTabs, ShouldResemble, tt.mockResponse.Data.ChatTabs)
+				}
+			})
+		}
+	}
+}
+
+func TestGroupChatMenuTreeAPI(t *testing.T) {
+	client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+	apis := map[string]func() (*GroupChatMenuTreeResponse, error){
+		"get": func() (*GroupChatMenuTreeResponse, error) {
+			return GroupChatMenuTreeGetAPI(context.Background(), client, "oc_1")
+		},
+		"create": func() (*GroupChatMenuTreeResponse, error) {
+			return GroupChatMenuTreeCreateAPI(context.Background(), client, "oc_1", GroupChatMenuTreeRequest{})
+		},
+		"delete": func() (*GroupChatMenuTreeResponse, error) {
+			return GroupChatMenuTreeDeleteAPI(context.Background(), client, "oc_1", GroupChatMenuTopLevelIDsRequest{})
+		},
+		"sort": func() (*GroupChatMenuTreeResponse, error) {
+			return GroupChatMenuTreeSortAPI(context.Background(), client, "oc_1", GroupChatMenuTopLevelIDsRequest{})
+		},
+	}
+
+	successResponse := GroupChatMenuTreeResponse{}
+	successResponse.Data.MenuTree.ChatMenuTopLevels = []GroupChatMenuTopLevel{
+		{ChatMenuTopLevelID: "7156553273518882844", ChatMenuItem: &GroupChatMenuItem{Name: "Runbook", ActionType: "NONE"}},
+	}
+
+	tests := []struct {
+		name         string
+		mockError    error
+		mockResponse GroupChatMenuTreeResponse
+		wantErr      bool
+	}{
+		{
+			name:         "success",
+			mockResponse: successResponse,
+			wantErr:      false,
+		},
+		{
+			name:      "error on request",
+			mockError: fmt.Errorf("request failed"),
+			wantErr:   true,
+		},
+		{
+			name: "error response code",
+			mockResponse: GroupChatMenuTreeResponse{
+				BaseResponse: BaseResponse{Code: 232011, Msg: "chat not found"},
+			},
+			wantErr: true,
+		},
+	}
+	for api, call := range apis {
+		for _, tt := range tests {
+			PatchConvey(fmt.Sprintf("%s: %s", api, tt.name), t, func() {
+				cleanup := SetupDoTenantRequest(tt.mockError, tt.mockResponse)
+				defer cleanup()
+
+				got, err := call()
+				if tt.wantErr {
+					So(err, ShouldNotBeNil)
+					So(got, ShouldBeNil)
+				} else {
+					So(err, ShouldBeNil)
+					So(got.Data.MenuTree, ShouldResemble, tt.mockResponse.Data.MenuTree)
+				}
+			})
+		}
+	}
+}
+
+func TestGroupChatTopNoticeAPI(t *testing.T) {
+	client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+	apis := map[string]func() (*BaseResponse, error){
+		"put": func() (*BaseResponse, error) {
+			return GroupChatTopNoticePutAPI(context.Background(), client, "oc_1", GroupChatTopNoticeRequest{
+				ChatTopNotice: []GroupChatTopNotice{{ActionType: "1", MessageID: "om_1"}},
+			})
+		},
+		"delete": func() (*BaseResponse, error) {
+			return GroupChatTopNoticeDeleteAPI(context.Background(), client, "oc_1")
+		},
+	}
+
+	tests := []struct {
+		name         string
+		mockError    error
+		mockResponse BaseResponse
+		wantErr      bool
+	}{
+		{
+			name:         "success",
+			mockResponse: BaseResponse{Code: 0, Msg: "success"},
+			wantErr:      false,
+		},
+		{
+			name:      "error on request",
+			mockError: fmt.Errorf("request failed"),
+			wantErr:   true,
+		},
+		{
+			name:         "error response code",
+			mockResponse: BaseResponse{Code: 232011, Msg: "chat not found"},
+			wantErr:      true,
+		},
+	}
+	for api, call := range apis {
+		for _, tt := range tests {
+			PatchConvey(fmt.Sprintf("%s: %s", api, tt.name), t, func() {
+				cleanup := SetupDoTenantRequest(tt.mockError, tt.mockResponse)
+				defer cleanup()
+
+				got, err := call()
+				if tt.wantErr {
+					So(err, ShouldNotBeNil)
+					So(got, ShouldBeNil)
+				} else {
+					So(err, ShouldBeNil)
+					So(got.Code, ShouldEqual, 0)
+				}
+			})
+		}
+	}
+}
+
+func TestMessageGetAPI(t *testing.T) {
+	successResponse := MessageGetResponse{}
+	successResponse.Data.Items = []Message{
+		{MessageID: "om_1", MsgType: "text", ChatID: "oc_1", Body: MessageBody{Content: `{"text":"hello"}`}},
+	}
+
+	tests := []struct {
+		name         string
+		mockError    error
+		mockResponse MessageGetResponse
+		wantErr      bool
+	}{
+		{
+			name:         "success get",
+			mockResponse: successResponse,
+			wantErr:      false,
+		},
+		{
+			name:      "error on get",
+			mockError: fmt.Errorf("request failed"),
+			wantErr:   true,
+		},
+		{
+			name: "error response code",
+			mockResponse: MessageGetResponse{
+				BaseResponse: BaseResponse{Code: 230011, Msg: "message recalled"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		PatchConvey(tt.name, t, func() {
+			cleanup := SetupDoTenantRequest(tt.mockError, tt.mockResponse)
+			defer cleanup()
+
+			client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+			got, err := MessageGetAPI(context.Background(), client, "om_1")
+			if tt.wantErr {
+				So(err, ShouldNotBeNil)
+				So(got, ShouldBeNil)
+				// Only the error code returned by Lark can tell a recalled message apart from a failed request.
+				So(IsAPIErrorCode(err, MESSAGE_RECALLED_CODE), ShouldEqual, tt.mockError == nil)
+			} else {
+				So(err, ShouldBeNil)
+				So(got.Data.Items, ShouldResemble, tt.mockResponse.Data.Items)
+			}
+		})
+	}
+}
+
+func TestMessageSendAPI(t *testing.T) {
+	successResponse := MessageResponse{}
+	successResponse.Data = Message{MessageID: "om_1", MsgType: "text", ChatID: "oc_1"}
+
+	tests := []struct {
+		name         string
+		request      MessageSendRequest
+		mockError    error
+		mockResponse MessageResponse
+		wantErr      bool
+	}{
+		{
+			name:         "success send with uuid",
+			request:      MessageSendRequest{ReceiveID: "oc_1", MsgType: "text", Content: `{"text":"hello"}`, UUID: "welcome-1"},
+			mockResponse: successResponse,
+			wantErr:      false,
+		},
+		{
+			name:         "success send without uuid",
+			request:      MessageSendRequest{ReceiveID: "oc_1", MsgType: "text", Content: `{"text":"hello"}`},
+			mockResponse: successResponse,
+			wantErr:      false,
+		},
+		{
+			name:      "error on send",
+			request:   MessageSendRequest{ReceiveID: "oc_1", MsgType: "text", Content: `{"text":"hello"}`},
+			mockError: fmt.Errorf("request failed"),
+			wantErr:   true,
+		},
+		{
+			name:    "error response code",
+			request: MessageSendRequest{ReceiveID: "oc_1", MsgType: "text", Content: `{"text":"hello"}`},
+			mockResponse: MessageResponse{
+				BaseResponse: BaseResponse{Code: 230002, Msg: "bot is not in the chat"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		PatchConvey(tt.name, t, func() {
+			sentUUIDs := []string{}
+			Mock((*LarkClient).DoTenantRequest).To(func(c *LarkClient, ctx context.Context, method HTTPMethod, path string, reqBody interface{}, resp interface{}) error {
+				So(path, ShouldEqual, IM_MESSAGE_API+"?receive_id_type=chat_id")
+				sentUUIDs = append(sentUUIDs, reqBody.(MessageSendRequest).UUID)
+				if tt.mockError != nil {
+					return tt.mockError
+				}
+				reflect.ValueOf(resp).Elem().Set(reflect.ValueOf(tt.mockResponse))
+				return nil
+			}).Build()
+
+			client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+			got, err := MessageSendAPI(context.Background(), client, "chat_id", tt.request)
+			So(sentUUIDs, ShouldHaveLength, 1)
+			So(sentUUIDs[0], ShouldNotBeEmpty)
+			if tt.request.UUID != "" {
+				So(sentUUIDs[0], ShouldEqual, tt.request.UUID)
+			}
+			if tt.wantErr {
+				So(err, ShouldNotBeNil)
+				So(got, ShouldBeNil)
+			} else {
+				So(err, ShouldBeNil)
+				So(got.Data.MessageID, ShouldEqual, "om_1")
+			}
+		})
+	}
+}
+
+func TestMessageChangeAPI(t *testing.T) {
+	client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+	apis := map[string]func() (*BaseResponse, error){
+		"update": func() (*BaseResponse, error) {
+			return MessageUpdateAPI(context.Background(), client, "om_1", MessageUpdateRequest{MsgType: "text", Content: `{"text":"hello"}`})
+		},
+		"patch card": func() (*BaseResponse, error) {
+			return MessageCardPatchAPI(context.Background(), client, "om_1", MessageUpdateRequest{Content: `{"elements":[]}`})
+		},
+		"recall": func() (*BaseResponse, error) {
+			return MessageRecallAPI(context.Background(), client, "om_1")
+		},
+		"pin": func() (*BaseResponse, error) {
+			return MessagePinAPI(context.Background(), client, "om_1")
+		},
+		"unpin": func() (*BaseResponse, error) {
+			return MessageUnpinAPI(context.Background(), client, "om_1")
+		},
+	}
+
+	tests := []struct {
+		name         string
+		mockError    error
+		mockResponse BaseResponse
+		wantErr      bool
+	}{
+		{
+			name:         "success",
+			mockResponse: BaseResponse{Code: 0, Msg: "success"},
+			wantErr:      false,
+		},
+		{
+			name:      "error on request",
+			mockError: fmt.Errorf("request failed"),
+			wantErr:   true,
+		},
+		{
+			name:         "error response code",
+			mockResponse: BaseResponse{Code: 230011, Msg: "message recalled"},
+			wantErr:      true,
+		},
+	}
+	for api, call := range apis {
+		for _, tt := range tests {
+			PatchConvey(fmt.Sprintf("%s: %s", api, tt.name), t, func() {
+				cleanup := SetupDoTenantRequest(tt.mockError, tt.mockResponse)
+				defer cleanup()
+
+				got, err := call()
+				if tt.wantErr {
+					So(err, ShouldNotBeNil)
+					So(got, ShouldBeNil)
+				} else {
+					So(err, ShouldBeNil)
+					So(got.Code, ShouldEqual, 0)
+				}
+			})
+		}
+	}
+}
+
+func TestGroupChatListAPI(t *testing.T) {
+	client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+	apis := map[string]struct {
+		call     func() (*GroupChatListResponse, error)
+		wantPath string
+	}{
+		"list": {
+			call: func() (*GroupChatListResponse, error) {
+				return GroupChatListAPI(context.Background(), client)
+			},
+			wantPath: GROUP_CHAT_API + "?page_size=100",
+		},
+		"search": {
+			call: func() (*GroupChatListResponse, error) {
+				return GroupChatSearchAPI(context.Background(), client, "On-call & Ops")
+			},
+			wantPath: GROUP_CHAT_API + "/search?page_size=100&query=On-call+%26+Ops",
+		},
+	}
+
+	firstPage := GroupChatListResponse{}
+	firstPage.Data.Items = []GroupChatListItem{{ChatID: "oc_1", Name: "On-call"}}
+	firstPage.Data.PageToken = "next_page"
+	firstPage.Data.HasMore = true
+	secondPage := GroupChatListResponse{}
+	secondPage.Data.Items = []GroupChatListItem{{ChatID: "oc_2", Name: "Ops"}}
+
+	tests := []struct {
+		name      string
+		responses []GroupChatListResponse
+		wantErr   bool
+		wantChats []GroupChatListItem
+	}{
+		{
+			name:      "success with multiple pages",
+			responses: []GroupChatListResponse{firstPage, secondPage},
+			wantErr:   false,
+			wantChats: []GroupChatListItem{{ChatID: "oc_1", Name: "On-call"}, {ChatID: "oc_2", Name: "Ops"}},
+		},
+		{
+			name:      "error on second page",
+			responses: []GroupChatListResponse{firstPage},
+			wantErr:   true,
+		},
+		{
+			name: "error response code",
+			responses: []GroupChatListResponse{
+				{BaseResponse: BaseResponse{Code: 99991663, Msg: "invalid access token"}},
+			},
+			wantErr: true,
+		},
+	}
+	for api, tc := range apis {
+		for _, tt := range tests {
+			PatchConvey(fmt.Sprintf("%s: %s", api, tt.name), t, func() {
+				paths := []string{}
+				Mock((*LarkClient).DoTenantRequest).To(func(c *LarkClient, ctx context.Context, method HTTPMethod, path string, reqBody interface{}, resp interface{}) error {
+					if len(paths) >= len(tt.responses) {
+						return fmt.Errorf("error on page %d", len(paths)+1)
+					}
+					*resp.(*GroupChatListResponse) = tt.responses[len(paths)]
+					paths = append(paths, path)
+					return nil
+				}).Build()
+
+				got, err := tc.call()
+				So(paths[0], ShouldEqual, tc.wantPath)
+				if tt.wantErr {
+					So(err, ShouldNotBeNil)
+					So(got, ShouldBeNil)
+				} else {
+					So(err, ShouldBeNil)
+					So(paths[1], ShouldEqual, tc.wantPath+"&page_token=next_page")
+					So(got.Data.Items, ShouldResemble, tt.wantChats)
+				}
+			})
+		}
+	}
+}
+
+func TestGroupChatGetWithUserIDTypeAPI(t *testing.T) {
+	successResponse := GroupChatGetResponse{}
+	successResponse.Data.OwnerID = "on_owner"
+	successResponse.Data.OwnerIDType = "union_id"
+	successResponse.Data.UserManagerIDList = []string{"on_admin"}
+
+	tests := []struct {
+		name         string
+		mockError    error
+		mockResponse GroupChatGetResponse
+		wantErr      bool
+	}{
+		{
+			name:         "success get",
+			mockResponse: successResponse,
+			wantErr:      false,
+		},
+		{
+			name:      "error on get",
+			mockError: fmt.Errorf("request failed"),
+			wantErr:   true,
+		},
+		{
+			name: "error response code",
+			mockResponse: GroupChatGetResponse{
+				BaseResponse: BaseResponse{Code: 232011, Msg: "chat not found"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		PatchConvey(tt.name, t, func() {
+			cleanup := SetupDoTenantRequest(tt.mockError, tt.mockResponse)
+			defer cleanup()
+
+			client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+			got, err := GroupChatGetWithUserIDTypeAPI(context.Background(), client, "oc_1", UNION_ID)
+			if tt.wantErr {
+				So(err, ShouldNotBeNil)
+				So(got, ShouldBeNil)
+			} else {
+				So(err, ShouldBeNil)
+				So(got.Data.OwnerID, ShouldEqual, "on_owner")
+				So(got.Data.UserManagerIDList, ShouldResemble, []string{"on_admin"})
+			}
+		})
+	}
+}
+
+func TestUserAPI(t *testing.T) {
+	client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+	apis := map[string]func() (*UserGetResponse, error){
+		"create": func() (*UserGetResponse, error) {
+			return UserCreateAPI(context.Background(), client, UserCreateRequest{BaseUser: BaseUser{Name: "User", Mobile: "+6281234567890", EmployeeType: 1}})
+		},
+		"get": func() (*UserGetResponse, error) {
+			return UserGetAPI(context.Background(), client, "ou_1", OPEN_ID)
+		},
+		"update": func() (*UserGetResponse, error) {
+			return UserUpdateAPI(context.Background(), client, "ou_1", UserUpdateRequest{BaseUser: BaseUser{Name: "User", Mobile: "+6281234567890", EmployeeType: 1}})
+		},
+		"update departments": func() (*UserGetResponse, error) {
+			return UserUpdateDepartmentsAPI(context.Background(), client, "ou_1", []string{"od-1"})
+		},
+	}
+
+	successResponse := UserGetResponse{}
+	successResponse.Data.User = User{OpenID: "ou_1", Name: "User"}
+
+	tests := []struct {
+		name         string
+		mockError    error
+		mockResponse UserGetResponse
+		wantErr      bool
+	}{
+		{
+			name:         "success",
+			mockResponse: successResponse,
+			wantErr:      false,
+		},
+		{
+			name:      "error on request",
+			mockError: fmt.Errorf("request failed"),
+			wantErr:   true,
+		},
+		{
+			name:         "error response code",
+			mockResponse: UserGetResponse{BaseResponse: BaseResponse{Code: 41050, Msg: "no user authority"}},
+			wantErr:      true,
+		},
+	}
+	for api, call := range apis {
+		for _, tt := range tests {
+			PatchConvey(fmt.Sprintf("%s: %s", api, tt.name), t, func() {
+				cleanup := SetupDoTenantRequest(tt.mockError, tt.mockResponse)
+				defer cleanup()
+
+				got, err := call()
+				if tt.wantErr {
+					So(err, ShouldNotBeNil)
+					So(got, ShouldBeNil)
+				} else {
+					So(err, ShouldBeNil)
+					So(got.Data.User.OpenID, ShouldEqual, "ou_1")
+				}
+			})
+		}
+	}
+}
+
+func TestUserCreateAPIClientToken(t *testing.T) {
+	PatchConvey("client token is set once before retries", t, func() {
+		paths := []string{}
+		Mock((*LarkClient).DoTenantRequest).To(func(c *LarkClient, ctx context.Context, method HTTPMethod, path string, reqBody interface{}, resp interface{}) error {
+			paths = append(paths, path)
+			return nil
+		}).Build()
+
+		client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+		_, err := UserCreateAPI(context.Background(), client, UserCreateRequest{})
+		So(err, ShouldBeNil)
+		So(paths, ShouldHaveLength, 1)
+		So(paths[0], ShouldStartWith, USER_API+"?user_id_type=open_id&department_id_type=open_department_id&client_token=")
+		So(len(paths[0]), ShouldBeGreaterThan, len(USER_API+"?user_id_type=open_id&department_id_type=open_department_id&client_token="))
+	})
+}
+
+func TestUserDeleteAPI(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockError    error
+		mockResponse BaseResponse
+		wantErr      bool
+	}{
+		{
+			name:         "success delete",
+			mockResponse: BaseResponse{Code: 0, Msg: "success"},
+			wantErr:      false,
+		},
+		{
+			name:      "error on delete",
+			mockError: fmt.Errorf("request failed"),
+			wantErr:   true,
+		},
+		{
+			name:         "error response code",
+			mockResponse: BaseResponse{Code: 41012, Msg: "invalid acceptor"},
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		PatchConvey(tt.name, t, func() {
+			cleanup := SetupDoTenantRequest(tt.mockError, tt.mockResponse)
+			defer cleanup()
+
+			client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+			got, err := UserDeleteAPI(context.Background(), client, "ou_1", UserDeleteRequest{DocsAcceptorUserID: "ou_2"})
+			if tt.wantErr {
+				So(err, ShouldNotBeNil)
+				So(got, ShouldBeNil)
+			} else {
+				So(err, ShouldBeNil)
+				So(got.Code, ShouldEqual, 0)
+			}
+		})
+	}
+}
+
+func TestUserListByDepartmentAPI(t *testing.T) {
+	firstPage := UserListResponse{}
+	firstPage.Data.Items = []User{{OpenID: "ou_1"}}
+	firstPage.Data.PageToken = "next_page"
+	firstPage.Data.HasMore = true
+	secondPage := UserListResponse{}
+	secondPage.Data.Items = []User{{OpenID: "ou_2"}}
+
+	tests := []struct {
+		name      string
+		responses []UserListResponse
+		wantErr   bool
+		wantUsers []User
+	}{
+		{
+			name:      "success with multiple pages",
+			responses: []UserListResponse{firstPage, secondPage},
+			wantErr:   false,
+			wantUsers: append(firstPage.Data.Items, secondPage.Data.Items...),
+		},
+		{
+			name:      "error on second page",
+			responses: []UserListResponse{firstPage},
+			wantErr:   true,
+		},
+		{
+			name: "error response code",
+			responses: []UserListResponse{
+				{BaseResponse: BaseResponse{Code: 40003, Msg: "department not found"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		PatchConvey(tt.name, t, func() {
+			paths := []string{}
+			Mock((*LarkClient).DoTenantRequest).To(func(c *LarkClient, ctx context.Context, method HTTPMethod, path string, reqBody interface{}, resp interface{}) error {
+				if len(paths) >= len(tt.responses) {
+					return fmt.Errorf("error on page %d", len(paths)+1)
+				}
+				*resp.(*UserListResponse) = tt.responses[len(paths)]
+				paths = append(paths, path)
+				return nil
+			}).Build()
+
+			client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+			got, err := UserListByDepartmentAPI(context.Background(), client, "od-1", OPEN_DEPARTMENT_ID, OPEN_ID)
+			So(paths[0], ShouldEqual, USER_API+"/find_by_department?department_id=od-1&department_id_type=open_department_id&user_id_type=open_id&page_size=50")
+			if tt.wantErr {
+				So(err, ShouldNotBeNil)
+				So(got, ShouldBeNil)
+			} else {
+				So(err, ShouldBeNil)
+				So(got.Data.Items, ShouldResemble, tt.wantUsers)
+			}
+		})
+	}
+}
+
+func TestGroupChatMemberListAPI(t *testing.T) {
+	firstPage := GroupChatMemberGetResponse{}
+	firstPage.Data.Items = []ListMember{{MemberID: "on_1", MemberIDType: "union_id", Name: "User1"}}
+	firstPage.Data.PageToken = "next_page"
+	firstPage.Data.HasMore = true
+	secondPage := GroupChatMemberGetResponse{}
+	secondPage.Data.Items = []ListMember{{MemberID: "on_2", MemberIDType: "union_id", Name: "User2"}}
+
+	tests := []struct {
+		name        string
+		responses   []GroupChatMemberGetResponse
+		wantErr     bool
+		wantMembers []ListMember
+	}{
+		{
+			name:        "success with multiple pages",
+			responses:   []GroupChatMemberGetResponse{firstPage, secondPage},
+			wantErr:     false,
+			wantMembers: append(firstPage.Data.Items, secondPage.Data.Items...),
+		},
+		{
+			name:      "error on second page",
+			responses: []GroupChatMemberGetResponse{firstPage},
+			wantErr:   true,
+		},
+		{
+			name: "error response code",
+			responses: []GroupChatMemberGetResponse{
+				{BaseResponse: BaseResponse{Code: 232011, Msg: "chat not found"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		PatchConvey(tt.name, t, func() {
+			paths := []string{}
+			Mock((*LarkClient).DoTenantRequest).To(func(c *LarkClient, ctx context.Context, method HTTPMethod, path string, reqBody interface{}, resp interface{}) error {
+				if len(paths) >= len(tt.responses) {
+					return fmt.Errorf("error on page %d", len(paths)+1)
+				}
+				*resp.(*GroupChatMemberGetResponse) = tt.responses[len(paths)]
+				paths = append(paths, path)
+				return nil
+			}).Build()
+
+			client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+			got, err := GroupChatMemberListAPI(context.Background(), client, "oc_1", UNION_ID)
+			So(paths[0], ShouldEqual, GROUP_CHAT_API+"/oc_1/members?member_id_type=union_id&page_size=100")
+			if tt.wantErr {
+				So(err, ShouldNotBeNil)
+				So(got, ShouldBeNil)
+			} else {
+				So(err, ShouldBeNil)
+				So(got.Data.Items, ShouldResemble, tt.wantMembers)
+				So(got.Data.MemberTotal, ShouldEqual, 2)
+			}
+		})
+	}
+}
+
+func TestGroupChatLinkAPI(t *testing.T) {
+	successResponse := GroupChatLinkResponse{}
+	successResponse.Data.ShareLink = "https://applink.larksuite.com/client/chat/chatter/add_by_link?link_token=abc"
+	successResponse.Data.ExpireTime = "1609296809"
+
+	tests := []struct {
+		name         string
+		mockError    error
+		mockResponse GroupChatLinkResponse
+		wantErr      bool
+	}{
+		{
+			name:         "success get",
+			mockResponse: successResponse,
+			wantErr:      false,
+		},
+		{
+			name:      "error on get",
+			mockError: fmt.Errorf("request failed"),
+			wantErr:   true,
+		},
+		{
+			name: "error response code",
+			mockResponse: GroupChatLinkResponse{
+				BaseResponse: BaseResponse{Code: 232033, Msg: "no permission to share the chat"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		PatchConvey(tt.name, t, func() {
+			cleanup := SetupDoTenantRequest(tt.mockError, tt.mockResponse)
+			defer cleanup()
+
+			client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+			got, err := GroupChatLinkAPI(context.Background(), client, "oc_1", GroupChatLinkRequest{ValidityPeriod: "week"})
+			if tt.wantErr {
+				So(err, ShouldNotBeNil)
+				So(got, ShouldBeNil)
+			} else {
+				So(err, ShouldBeNil)
+				So(got.Data, ShouldResemble, tt.mockResponse.Data)
+			}
+		})
+	}
+}
+
+func TestUnitAPI(t *testing.T) {
+	client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+	apis := map[string]func() (*BaseResponse, error){
+		"update": func() (*BaseResponse, error) {
+			return UnitUpdateAPI(context.Background(), client, "unit_1", UnitUpdateRequest{Name: "Unit"})
+		},
+		"delete": func() (*BaseResponse, error) {
+			return UnitDeleteAPI(context.Background(), client, "unit_1")
+		},
+		"bind department": func() (*BaseResponse, error) {
+			return UnitBindDepartmentAPI(context.Background(), client, UnitDepartmentRequest{UnitID: "unit_1", DepartmentID: "od-1", DepartmentIDType: OPEN_DEPARTMENT_ID})
+		},
+		"unbind department": func() (*BaseResponse, error) {
+			return UnitUnbindDepartmentAPI(context.Background(), client, UnitDepartmentRequest{UnitID: "unit_1", DepartmentID: "od-1", DepartmentIDType: OPEN_DEPARTMENT_ID})
+		},
+	}
+
+	tests := []struct {
+		name         string
+		mockError    error
+		mockResponse BaseResponse
+		wantErr      bool
+	}{
+		{
+			name:         "success",
+			mockResponse: BaseResponse{Code: 0, Msg: "success"},
+			wantErr:      false,
+		},
+		{
+			name:      "error on request",
+			mockError: fmt.Errorf("request failed"),
+			wantErr:   true,
+		},
+		{
+			name:         "error response code",
+			mockResponse: BaseResponse{Code: 40003, Msg: "unit not found"},
+			wantErr:      true,
+		},
+	}
+	for api, call := range apis {
+		for _, tt := range tests {
+			PatchConvey(fmt.Sprintf("%s: %s", api, tt.name), t, func() {
+				cleanup := SetupDoTenantRequest(tt.mockError, tt.mockResponse)
+				defer cleanup()
+
+				got, err := call()
+				if tt.wantErr {
+					So(err, ShouldNotBeNil)
+					So(got, ShouldBeNil)
+				} else {
+					So(err, ShouldBeNil)
+					So(got.Code, ShouldEqual, 0)
+				}
+			})
+		}
+	}
+}
+
+func TestUnitCreateAPI(t *testing.T) {
+	successResponse := UnitCreateResponse{}
+	successResponse.Data.UnitID = "unit_1"
+
+	tests := []struct {
+		name         string
+		mockError    error
+		mockResponse UnitCreateResponse
+		wantErr      bool
+	}{
+		{
+			name:         "success",
+			mockResponse: successResponse,
+			wantErr:      false,
+		},
+		{
+			name:      "error on request",
+			mockError: fmt.Errorf("request failed"),
+			wantErr:   true,
+		},
+		{
+			name:         "error response code",
+			mockResponse: UnitCreateResponse{BaseResponse: BaseResponse{Code: 40001, Msg: "unit id already exists"}},
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		PatchConvey(tt.name, t, func() {
+			cleanup := SetupDoTenantRequest(tt.mockError, tt.mockResponse)
+			defer cleanup()
+
+			client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+			got, err := UnitCreateAPI(context.Background(), client, UnitCreateRequest{BaseUnit: BaseUnit{Name: "Unit", UnitType: "subsidiary"}})
+			if tt.wantErr {
+				So(err, ShouldNotBeNil)
+				So(got, ShouldBeNil)
+			} else {
+				So(err, ShouldBeNil)
+				So(got.Data.UnitID, ShouldEqual, "unit_1")
+			}
+		})
+	}
+}
+
+func TestUnitGetAPI(t *testing.T) {
+	successResponse := UnitGetResponse{}
+	successResponse.Data.Unit = Unit{BaseUnit: BaseUnit{Name: "Unit", UnitType: "subsidiary"}, UnitID: "unit_1"}
+
+	tests := []struct {
+		name         string
+		mockError    error
+		mockResponse UnitGetResponse
+		wantErr      bool
+	}{
+		{
+			name:         "success",
+			mockResponse: successResponse,
+			wantErr:      false,
+		},
+		{
+			name:      "error on request",
+			mockError: fmt.Errorf("request failed"),
+			wantErr:   true,
+		},
+		{
+			name:         "error response code",
+			mockResponse: UnitGetResponse{BaseResponse: BaseResponse{Code: 40003, Msg: "unit not found"}},
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		PatchConvey(tt.name, t, func() {
+			cleanup := SetupDoTenantRequest(tt.mockError, tt.mockResponse)
+			defer cleanup()
+
+			client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+			got, err := UnitGetAPI(context.Background(), client, "unit_1")
+			if tt.wantErr {
+				So(err, ShouldNotBeNil)
+				So(got, ShouldBeNil)
+			} else {
+				So(err, ShouldBeNil)
+				So(got.Data.Unit, ShouldResemble, successResponse.Data.Unit)
+			}
+		})
+	}
+}
+
+func TestUnitListAPI(t *testing.T) {
+	firstPage := UnitListResponse{}
+	firstPage.Data.UnitList = []Unit{{UnitID: "unit_1"}}
+	firstPage.Data.PageToken = "next_page"
+	firstPage.Data.HasMore = true
+	secondPage := UnitListResponse{}
+	secondPage.Data.UnitList = []Unit{{UnitID: "unit_2"}}
+
+	tests := []struct {
+		name      string
+		responses []UnitListResponse
+		wantErr   bool
+		wantUnits []Unit
+	}{
+		{
+			name:      "success with multiple pages",
+			responses: []UnitListResponse{firstPage, secondPage},
+			wantErr:   false,
+			wantUnits: append(firstPage.Data.UnitList, secondPage.Data.UnitList...),
+		},
+		{
+			name:      "error on second page",
+			responses: []UnitListResponse{firstPage},
+			wantErr:   true,
+		},
+		{
+			name: "error response code",
+			responses: []UnitListResponse{
+				{BaseResponse: BaseResponse{Code: 99991672, Msg: "no permission"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		PatchConvey(tt.name, t, func() {
+			paths := []string{}
+			Mock((*LarkClient).DoTenantRequest).To(func(c *LarkClient, ctx context.Context, method HTTPMethod, path string, reqBody interface{}, resp interface{}) error {
+				if len(paths) >= len(tt.responses) {
+					return fmt.Errorf("error on page %d", len(paths)+1)
+				}
+				*resp.(*UnitListResponse) = tt.responses[len(paths)]
+				paths = append(paths, path)
+				return nil
+			}).Build()
+
+			client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+			got, err := UnitListAPI(context.Background(), client)
+			So(paths[0], ShouldEqual, UNIT_API+"?page_size=100")
+			if tt.wantErr {
+				So(err, ShouldNotBeNil)
+				So(got, ShouldBeNil)
+			} else {
+				So(err, ShouldBeNil)
+				So(paths[1], ShouldEndWith, "&page_token=next_page")
+				So(got.Data.UnitList, ShouldResemble, tt.wantUnits)
+			}
+		})
+	}
+}
+
+func TestUnitDepartmentListAPI(t *testing.T) {
+	firstPage := UnitDepartmentListResponse{}
+	firstPage.Data.DepartmentList = []UnitDepartment{{UnitID: "unit_1", DepartmentID: "od-1"}}
+	firstPage.Data.PageToken = "next_page"
+	firstPage.Data.HasMore = true
+	secondPage := UnitDepartmentListResponse{}
+	secondPage.Data.DepartmentList = []UnitDepartment{{UnitID: "unit_1", DepartmentID: "od-2"}}
+
+	tests := []struct {
+		name            string
+		responses       []UnitDepartmentListResponse
+		wantErr         bool
+		wantDepartments []UnitDepartment
+	}{
+		{
+			name:            "success with multiple pages",
+			responses:       []UnitDepartmentListResponse{firstPage, secondPage},
+			wantErr:         false,
+			wantDepartments: append(firstPage.Data.DepartmentList, secondPage.Data.DepartmentList...),
+		},
+		{
+			name:      "error on second page",
+			responses: []UnitDepartmentListResponse{firstPage},
+			wantErr:   true,
+		},
+		{
+			name: "error response code",
+			responses: []UnitDepartmentListResponse{
+				{BaseResponse: BaseResponse{Code: 40003, Msg: "unit not found"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		PatchConvey(tt.name, t, func() {
+			paths := []string{}
+			Mock((*LarkClient).DoTenantRequest).To(func(c *LarkClient, ctx context.Context, method HTTPMethod, path string, reqBody interface{}, resp interface{}) error {
+				if len(paths) >= len(tt.responses) {
+					return fmt.Errorf("error on page %d", len(paths)+1)
+				}
+				*resp.(*UnitDepartmentListResponse) = tt.responses[len(paths)]
+				paths = append(paths, path)
+				return nil
+			}).Build()
+
+			client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+			got, err := UnitDepartmentListAPI(context.Background(), client, "unit_1", OPEN_DEPARTMENT_ID)
+			So(paths[0], ShouldEqual, UNIT_API+"/list_department?unit_id=unit_1&department_id_type=open_department_id&page_size=100")
+			if tt.wantErr {
+				So(err, ShouldNotBeNil)
+				So(got, ShouldBeNil)
+			} else {
+				So(err, ShouldBeNil)
+				So(paths[1], ShouldEndWith, "&page_token=next_page")
+				So(got.Data.DepartmentList, ShouldResemble, tt.wantDepartments)
+			}
+		})
+	}
+}
+
+func TestJobLevelAPI(t *testing.T) {
+	client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+	apis := map[string]func() (*JobLevelResponse, error){
+		"create": func() (*JobLevelResponse, error) {
+			return JobLevelCreateAPI(context.Background(), client, JobLevelRequest{Name: "Senior", Rank: 3, Status: true})
+		},
+		"get": func() (*JobLevelResponse, error) {
+			return JobLevelGetAPI(context.Background(), client, "level_1")
+		},
+		"update": func() (*JobLevelResponse, error) {
+			return JobLevelUpdateAPI(context.Background(), client, "level_1", JobLevelRequest{Name: "Senior", Rank: 4, Status: true})
+		},
+	}
+
+	successResponse := JobLevelResponse{}
+	successResponse.Data.JobLevel = JobLevel{JobLevelID: "level_1"}
+
+	tests := []struct {
+		name         string
+		mockError    error
+		mockResponse JobLevelResponse
+		wantErr      bool
+	}{
+		{
+			name:         "success",
+			mockResponse: successResponse,
+			wantErr:      false,
+		},
+		{
+			name:      "error on request",
+			mockError: fmt.Errorf("request failed"),
+			wantErr:   true,
+		},
+		{
+			name:         "error response code",
+			mockResponse: JobLevelResponse{BaseResponse: BaseResponse{Code: 40003, Msg: "job level not found"}},
+			wantErr:      true,
+		},
+	}
+	for api, call := range apis {
+		for _, tt := range tests {
+			PatchConvey(fmt.Sprintf("%s: %s", api, tt.name), t, func() {
+				cleanup := SetupDoTenantRequest(tt.mockError, tt.mockResponse)
+				defer cleanup()
+
+				got, err := call()
+				if tt.wantErr {
+					So(err, ShouldNotBeNil)
+					So(got, ShouldBeNil)
+				} else {
+					So(err, ShouldBeNil)
+					So(got.Data.JobLevel.JobLevelID, ShouldEqual, "level_1")
+				}
+			})
+		}
+	}
+}
+
+func TestJobFamilyAPI(t *testing.T) {
+	client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+	apis := map[string]func() (*JobFamilyResponse, error){
+		"create": func() (*JobFamilyResponse, error) {
+			return JobFamilyCreateAPI(context.Background(), client, JobFamilyRequest{Name: "Engineering", Status: true})
+		},
+		"get": func() (*JobFamilyResponse, error) {
+			return JobFamilyGetAPI(context.Background(), client, "family_1")
+		},
+		"update": func() (*JobFamilyResponse, error) {
+			return JobFamilyUpdateAPI(context.Background(), client, "family_1", JobFamilyRequest{Name: "Engineering", ParentJobFamilyID: "family_0", Status: true})
+		},
+	}
+
+	successResponse := JobFamilyResponse{}
+	successResponse.Data.JobFamily = JobFamily{JobFamilyID: "family_1"}
+
+	tests := []struct {
+		name         string
+		mockError    error
+		mockResponse JobFamilyResponse
+		wantErr      bool
+	}{
+		{
+			name:         "success",
+			mockResponse: successResponse,
+			wantErr:      false,
+		},
+		{
+			name:      "error on request",
+			mockError: fmt.Errorf("request failed"),
+			wantErr:   true,
+		},
+		{
+			name:         "error response code",
+			mockResponse: JobFamilyResponse{BaseResponse: BaseResponse{Code: 40003, Msg: "job family not found"}},
+			wantErr:      true,
+		},
+	}
+	for api, call := range apis {
+		for _, tt := range tests {
+			PatchConvey(fmt.Sprintf("%s: %s", api, tt.name), t, func() {
+				cleanup := SetupDoTenantRequest(tt.mockError, tt.mockResponse)
+				defer cleanup()
+
+				got, err := call()
+				if tt.wantErr {
+					So(err, ShouldNotBeNil)
+					So(got, ShouldBeNil)
+				} else {
+					So(err, ShouldBeNil)
+					So(got.Data.JobFamily.JobFamilyID, ShouldEqual, "family_1")
+				}
+			})
+		}
+	}
+}
+
+func TestJobDeleteAPI(t *testing.T) {
+	client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+	apis := map[string]func() (*BaseResponse, error){
+		"delete job level": func() (*BaseResponse, error) {
+			return JobLevelDeleteAPI(context.Background(), client, "level_1")
+		},
+		"delete job family": func() (*BaseResponse, error) {
+			return JobFamilyDeleteAPI(context.Background(), client, "family_1")
+		},
+	}
+
+	successResponse := BaseResponse{Code: 0, Msg: "success"}
+
+	tests := []struct {
+		name         string
+		mockError    error
+		mockResponse BaseResponse
+		wantErr      bool
+	}{
+		{
+			name:         "success",
+			mockResponse: successResponse,
+			wantErr:      false,
+		},
+		{
+			name:      "error on request",
+			mockError: fmt.Errorf("request failed"),
+			wantErr:   true,
+		},
+		{
+			name:         "error response code",
+			mockResponse: BaseResponse{Code: 40003, Msg: "not found"},
+			wantErr:      true,
+		},
+	}
+	for api, call := range apis {
+		for _, tt := range tests {
+			PatchConvey(fmt.Sprintf("%s: %s", api, tt.name), t, func() {
+				cleanup := SetupDoTenantRequest(tt.mockError, tt.mockResponse)
+				defer cleanup()
+
+				got, err := call()
+				if tt.wantErr {
+					So(err, ShouldNotBeNil)
+					So(got, ShouldBeNil)
+				} else {
+					So(err, ShouldBeNil)
+					So(got.Code, ShouldEqual, 0)
+				}
+			})
+		}
+	}
+}
+
+func TestJobTitleGetAPI(t *testing.T) {
+	client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+	apis := map[string]func() (*JobTitleGetResponse, error){
+		"get": func() (*JobTitleGetResponse, error) {
+			return JobTitleGetAPI(context.Background(), client, "title_1")
+		},
+	}
+
+	successResponse := JobTitleGetResponse{}
+	successResponse.Data.JobTitle = JobTitle{JobTitleID: "title_1", Name: "Engineer", Status: true}
+
+	tests := []struct {
+		name         string
+		mockError    error
+		mockResponse JobTitleGetResponse
+		wantErr      bool
+	}{
+		{
+			name:         "success",
+			mockResponse: successResponse,
+			wantErr:      false,
+		},
+		{
+			name:      "error on request",
+			mockError: fmt.Errorf("request failed"),
+			wantErr:   true,
+		},
+		{
+			name:         "error response code",
+			mockResponse: JobTitleGetResponse{BaseResponse: BaseResponse{Code: 40003, Msg: "job title not found"}},
+			wantErr:      true,
+		},
+	}
+	for api, call := range apis {
+		for _, tt := range tests {
+			PatchConvey(fmt.Sprintf("%s: %s", api, tt.name), t, func() {
+				cleanup := SetupDoTenantRequest(tt.mockError, tt.mockResponse)
+				defer cleanup()
+
+				got, err := call()
+				if tt.wantErr {
+					So(err, ShouldNotBeNil)
+					So(got, ShouldBeNil)
+				} else {
+					So(err, ShouldBeNil)
+					So(got.Data.JobTitle, ShouldResemble, successResponse.Data.JobTitle)
+				}
+			})
+		}
+	}
+}
+
+func TestJobTitleListAPI(t *testing.T) {
+	firstPage := JobTitleListResponse{}
+	firstPage.Data.Items = []JobTitle{{JobTitleID: "title_1"}}
+	firstPage.Data.PageToken = "next_page"
+	firstPage.Data.HasMore = true
+	secondPage := JobTitleListResponse{}
+	secondPage.Data.Items = []JobTitle{{JobTitleID: "title_2"}}
+
+	tests := []struct {
+		name          string
+		responses     []JobTitleListResponse
+		wantErr       bool
+		wantJobTitles []JobTitle
+	}{
+		{
+			name:          "success with multiple pages",
+			responses:     []JobTitleListResponse{firstPage, secondPage},
+			wantErr:       false,
+			wantJobTitles: append(firstPage.Data.Items, secondPage.Data.Items...),
+		},
+		{
+			name:      "error on second page",
+			responses: []JobTitleListResponse{firstPage},
+			wantErr:   true,
+		},
+		{
+			name: "error response code",
+			responses: []JobTitleListResponse{
+				{BaseResponse: BaseResponse{Code: 99991672, Msg: "no permission"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		PatchConvey(tt.name, t, func() {
+			paths := []string{}
+			Mock((*LarkClient).DoTenantRequest).To(func(c *LarkClient, ctx context.Context, method HTTPMethod, path string, reqBody interface{}, resp interface{}) error {
+				if len(paths) >= len(tt.responses) {
+					return fmt.Errorf("error on page %d", len(paths)+1)
+				}
+				*resp.(*JobTitleListResponse) = tt.responses[len(paths)]
+				paths = append(paths, path)
+				return nil
+			}).Build()
+
+			client := NewLarkClient("tenant-token", "app-token", "app-id", BASE_DELAY, BASE_RETRY_COUNT)
+			got, err := JobTitleListAPI(context.Background(), client)
+			So(paths[0], ShouldEqual, JOB_TITLE_API+"?page_size=100")
+			if tt.wantErr {
+				So(err, ShouldNotBeNil)
+				So(got, ShouldBeNil)
+			} else {
+				So(err, ShouldBeNil)
+				So(paths[1], ShouldEndWith, "&page_token=next_page")
+				So(got.Data.Items, ShouldResemble, tt.wantJobTitles)
+			}
+		})
+	}
+}
@@ -15,6 +15,26 @@ type BaseResponse struct {
 	Msg  string `json:"msg"`
 }
 
+// APIError is an error code returned by Lark. It's wrapped in the returned error, so that the
+// callers can tell an object that doesn't exist apart from a request that failed.
+type APIError struct {
+	Code int
+	Msg  string
+}
+
+func (e *APIError) Error() string {
+	return e.Msg
+}
+
+// MultipartRequest is the request body for the endpoints that expect multipart/form-data, such as file uploads.
+// Pass it as the request body of DoRequest instead of a JSON serializable struct.
+type MultipartRequest struct {
+	Fields    map[string]string
+	FileField string
+	FileName  string
+	File      []byte
+}
+
 // Access Token Request.
 type AccessTokenRequest struct {
 	AppID     string `json:"app_id,omitempty"`
@@ -68,3 +68,12 @@ type DepartmentDeleteResponse struct {
 type DepartmentUpdateIDRequest struct {
 	NewDepartmentID string `json:"new_department_id"`
 }
+
+type DepartmentListResponse struct {
+	BaseResponse
+	Data struct {
+		Items     []Department `json:"items"`
+		PageToken string       `json:"page_token"`
+		HasMore   bool         `json:"has_more"`
+	} `json:"data"`
+}
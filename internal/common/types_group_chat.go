@@ -89,6 +89,22 @@ type GroupChatSpeechScopesUpdateRequest struct {
 	ModeratorRemovedList []string `json:"moderator_removed_list,omitempty"`
 }
 
+type GroupChatModerator struct {
+	UserIDType string `json:"user_id_type"`
+	UserID     string `json:"user_id"`
+	TenantKey  string `json:"tenant_key"`
+}
+
+type GroupChatModerationGetResponse struct {
+	BaseResponse
+	Data struct {
+		ModerationSetting string               `json:"moderation_setting"`
+		PageToken         string               `json:"page_token"`
+		HasMore           bool                 `json:"has_more"`
+		Items             []GroupChatModerator `json:"items"`
+	} `json:"data"`
+}
+
 type GroupChatGetResponse struct {
 	BaseResponse
 	Data struct {
@@ -122,3 +138,216 @@ type GroupChatGetResponse struct {
 		ChatStatus             string                `json:"chat_status"`
 	} `json:"data"`
 }
+
+type GroupChatAnnouncementGetResponse struct {
+	BaseResponse
+	Data struct {
+		Content        string `json:"content"`
+		Revision       string `json:"revision"`
+		CreateTime     string `json:"create_time"`
+		UpdateTime     string `json:"update_time"`
+		OwnerIDType    string `json:"owner_id_type"`
+		OwnerID        string `json:"owner_id"`
+		ModifierIDType string `json:"modifier_id_type"`
+		ModifierID     string `json:"modifier_id"`
+	} `json:"data"`
+}
+
+// GroupChatAnnouncementUpdateRequest updates the announcement, every request is a JSON encoded AnnouncementChangeRequest.
+type GroupChatAnnouncementUpdateRequest struct {
+	Revision string   `json:"revision"`
+	Requests []string `json:"requests"`
+}
+
+// AnnouncementDocument is the content of a group chat announcement, in the document format of the docs API.
+type AnnouncementDocument struct {
+	Body AnnouncementBody `json:"body"`
+}
+
+type AnnouncementBody struct {
+	Blocks []AnnouncementBlock `json:"blocks"`
+}
+
+type AnnouncementBlock struct {
+	Type      string                 `json:"type"`
+	Paragraph *AnnouncementParagraph `json:"paragraph,omitempty"`
+}
+
+type AnnouncementParagraph struct {
+	Elements []AnnouncementElement      `json:"elements"`
+	Style    AnnouncementParagraphStyle `json:"style"`
+}
+
+type AnnouncementParagraphStyle struct {
+	HeadingLevel int               `json:"headingLevel,omitempty"`
+	List         *AnnouncementList `json:"list,omitempty"`
+}
+
+type AnnouncementList struct {
+	Type        string `json:"type"`
+	IndentLevel int    `json:"indentLevel"`
+	Number      int    `json:"number,omitempty"`
+}
+
+type AnnouncementElement struct {
+	Type    string               `json:"type"`
+	TextRun *AnnouncementTextRun `json:"textRun,omitempty"`
+}
+
+type AnnouncementTextRun struct {
+	Text  string                `json:"text"`
+	Style AnnouncementTextStyle `json:"style"`
+}
+
+type AnnouncementTextStyle struct {
+	Bold bool `json:"bold,omitempty"`
+}
+
+type AnnouncementChangeRequest struct {
+	RequestType               string                                 `json:"requestType"`
+	InsertBlocksRequest       *AnnouncementInsertBlocksRequest       `json:"insertBlocksRequest,omitempty"`
+	DeleteContentRangeRequest *AnnouncementDeleteContentRangeRequest `json:"deleteContentRangeRequest,omitempty"`
+}
+
+type AnnouncementInsertBlocksRequest struct {
+	// Payload is a JSON encoded AnnouncementBody.
+	Payload  string               `json:"payload"`
+	Location AnnouncementLocation `json:"location"`
+}
+
+type AnnouncementLocation struct {
+	ZoneID    string `json:"zoneId"`
+	Index     int    `json:"index"`
+	EndOfZone bool   `json:"endOfZone"`
+}
+
+type AnnouncementDeleteContentRangeRequest struct {
+	DeleteRange AnnouncementRange `json:"deleteRange"`
+}
+
+type AnnouncementRange struct {
+	ZoneID     string `json:"zoneId"`
+	StartIndex int    `json:"startIndex"`
+	EndIndex   int    `json:"endIndex"`
+}
+
+type GroupChatTabContent struct {
+	URL string `json:"url,omitempty"`
+	Doc string `json:"doc,omitempty"`
+}
+
+type GroupChatTabConfig struct {
+	IconKey   string `json:"icon_key,omitempty"`
+	IsBuiltIn bool   `json:"is_built_in,omitempty"`
+}
+
+type GroupChatTab struct {
+	TabID      string               `json:"tab_id,omitempty"`
+	TabName    string               `json:"tab_name,omitempty"`
+	TabType    string               `json:"tab_type,omitempty"`
+	TabContent *GroupChatTabContent `json:"tab_content,omitempty"`
+	TabConfig  *GroupChatTabConfig  `json:"tab_config,omitempty"`
+}
+
+type GroupChatTabsRequest struct {
+	ChatTabs []GroupChatTab `json:"chat_tabs"`
+}
+
+type GroupChatTabIDsRequest struct {
+	TabIDs []string `json:"tab_ids"`
+}
+
+type GroupChatTabsResponse struct {
+	BaseResponse
+	Data struct {
+		ChatTabs []GroupChatTab `json:"chat_tabs"`
+	} `json:"data"`
+}
+
+type GroupChatMenuRedirectLink struct {
+	CommonURL  string `json:"common_url,omitempty"`
+	IosURL     string `json:"ios_url,omitempty"`
+	AndroidURL string `json:"android_url,omitempty"`
+	PcURL      string `json:"pc_url,omitempty"`
+	WebURL     string `json:"web_url,omitempty"`
+}
+
+type GroupChatMenuItem struct {
+	ActionType   string                     `json:"action_type,omitempty"`
+	RedirectLink *GroupChatMenuRedirectLink `json:"redirect_link,omitempty"`
+	ImageKey     string                     `json:"image_key,omitempty"`
+	Name         string                     `json:"name,omitempty"`
+}
+
+type GroupChatMenuSecondLevel struct {
+	ChatMenuSecondLevelID string             `json:"chat_menu_second_level_id,omitempty"`
+	ChatMenuItem          *GroupChatMenuItem `json:"chat_menu_item,omitempty"`
+}
+
+type GroupChatMenuTopLevel struct {
+	ChatMenuTopLevelID string                     `json:"chat_menu_top_level_id,omitempty"`
+	ChatMenuItem       *GroupChatMenuItem         `json:"chat_menu_item,omitempty"`
+	Children           []GroupChatMenuSecondLevel `json:"children,omitempty"`
+}
+
+type GroupChatMenuTree struct {
+	ChatMenuTopLevels []GroupChatMenuTopLevel `json:"chat_menu_top_levels"`
+}
+
+type GroupChatMenuTreeRequest struct {
+	MenuTree GroupChatMenuTree `json:"menu_tree"`
+}
+
+type GroupChatMenuTopLevelIDsRequest struct {
+	ChatMenuTopLevelIDs []string `json:"chat_menu_top_level_ids"`
+}
+
+type GroupChatMenuTreeResponse struct {
+	BaseResponse
+	Data struct {
+		MenuTree GroupChatMenuTree `json:"menu_tree"`
+	} `json:"data"`
+}
+
+type GroupChatTopNotice struct {
+	ActionType string `json:"action_type"`
+	MessageID  string `json:"message_id,omitempty"`
+}
+
+type GroupChatTopNoticeRequest struct {
+	ChatTopNotice []GroupChatTopNotice `json:"chat_top_notice"`
+}
+
+type GroupChatListItem struct {
+	ChatID      string `json:"chat_id"`
+	Avatar      string `json:"avatar"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	OwnerID     string `json:"owner_id"`
+	OwnerIDType string `json:"owner_id_type"`
+	External    bool   `json:"external"`
+	TenantKey   string `json:"tenant_key"`
+	ChatStatus  string `json:"chat_status"`
+}
+
+type GroupChatListResponse struct {
+	BaseResponse
+	Data struct {
+		Items     []GroupChatListItem `json:"items"`
+		PageToken string              `json:"page_token"`
+		HasMore   bool                `json:"has_more"`
+	} `json:"data"`
+}
+
+type GroupChatLinkRequest struct {
+	ValidityPeriod string `json:"validity_period"`
+}
+
+type GroupChatLinkResponse struct {
+	BaseResponse
+	Data struct {
+		ShareLink   string `json:"share_link"`
+		ExpireTime  string `json:"expire_time"`
+		IsPermanent bool   `json:"is_permanent"`
+	} `json:"data"`
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+type ImageUploadResponse struct {
+	BaseResponse
+	Data struct {
+		ImageKey string `json:"image_key"`
+	} `json:"data"`
+}
+
+type MessageBody struct {
+	Content string `json:"content"`
+}
+
+type Message struct {
+	MessageID  string      `json:"message_id"`
+	RootID     string      `json:"root_id,omitempty"`
+	ParentID   string      `json:"parent_id,omitempty"`
+	MsgType    string      `json:"msg_type"`
+	CreateTime string      `json:"create_time"`
+	UpdateTime string      `json:"update_time"`
+	Deleted    bool        `json:"deleted"`
+	Updated    bool        `json:"updated"`
+	ChatID     string      `json:"chat_id"`
+	Body       MessageBody `json:"body"`
+}
+
+type MessageGetResponse struct {
+	BaseResponse
+	Data struct {
+		Items []Message `json:"items"`
+	} `json:"data"`
+}
+
+// MessageSendRequest sends a message. Lark sends only one message for the same UUID within an hour.
+type MessageSendRequest struct {
+	ReceiveID string `json:"receive_id"`
+	MsgType   string `json:"msg_type"`
+	Content   string `json:"content"`
+	UUID      string `json:"uuid,omitempty"`
+}
+
+type MessageUpdateRequest struct {
+	MsgType string `json:"msg_type,omitempty"`
+	Content string `json:"content"`
+}
+
+type MessageResponse struct {
+	BaseResponse
+	Data Message `json:"data"`
+}
+
+type MessagePinRequest struct {
+	MessageID string `json:"message_id"`
+}
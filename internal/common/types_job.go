@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+type JobLevelRequest struct {
+	Name            string        `json:"name"`
+	Description     string        `json:"description"`
+	Rank            int64         `json:"rank"`
+	Status          bool          `json:"status"`
+	I18nName        []I18nContent `json:"i18n_name"`
+	I18nDescription []I18nContent `json:"i18n_description"`
+}
+
+type JobLevel struct {
+	JobLevelRequest
+	JobLevelID string `json:"job_level_id"`
+}
+
+type JobLevelResponse struct {
+	BaseResponse
+	Data struct {
+		JobLevel JobLevel `json:"job_level"`
+	} `json:"data"`
+}
+
+type JobFamilyRequest struct {
+	Name              string        `json:"name"`
+	Description       string        `json:"description"`
+	ParentJobFamilyID string        `json:"parent_job_family_id"`
+	Status            bool          `json:"status"`
+	I18nName          []I18nContent `json:"i18n_name"`
+	I18nDescription   []I18nContent `json:"i18n_description"`
+}
+
+type JobFamily struct {
+	JobFamilyRequest
+	JobFamilyID string `json:"job_family_id"`
+}
+
+type JobFamilyResponse struct {
+	BaseResponse
+	Data struct {
+		JobFamily JobFamily `json:"job_family"`
+	} `json:"data"`
+}
+
+type JobTitle struct {
+	JobTitleID string        `json:"job_title_id"`
+	Name       string        `json:"name"`
+	I18nName   []I18nContent `json:"i18n_name"`
+	Status     bool          `json:"status"`
+}
+
+type JobTitleGetResponse struct {
+	BaseResponse
+	Data struct {
+		JobTitle JobTitle `json:"job_title"`
+	} `json:"data"`
+}
+
+type JobTitleListResponse struct {
+	BaseResponse
+	Data struct {
+		Items     []JobTitle `json:"items"`
+		HasMore   bool       `json:"has_more"`
+		PageToken string     `json:"page_token"`
+	} `json:"data"`
+}
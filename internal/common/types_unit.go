@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+type BaseUnit struct {
+	Name     string `json:"name"`
+	UnitType string `json:"unit_type"`
+}
+
+type UnitCreateRequest struct {
+	BaseUnit
+	UnitID string `json:"unit_id,omitempty"`
+}
+
+type UnitCreateResponse struct {
+	BaseResponse
+	Data struct {
+		UnitID string `json:"unit_id"`
+	} `json:"data"`
+}
+
+type UnitUpdateRequest struct {
+	Name string `json:"name"`
+}
+
+type Unit struct {
+	BaseUnit
+	UnitID string `json:"unit_id"`
+}
+
+type UnitGetResponse struct {
+	BaseResponse
+	Data struct {
+		Unit Unit `json:"unit"`
+	} `json:"data"`
+}
+
+type UnitListResponse struct {
+	BaseResponse
+	Data struct {
+		UnitList  []Unit `json:"unitlist"`
+		HasMore   bool   `json:"has_more"`
+		PageToken string `json:"page_token"`
+	} `json:"data"`
+}
+
+type UnitDepartmentRequest struct {
+	UnitID           string           `json:"unit_id"`
+	DepartmentID     string           `json:"department_id"`
+	DepartmentIDType DepartmentIDType `json:"department_id_type"`
+}
+
+type UnitDepartment struct {
+	UnitID       string `json:"unit_id"`
+	DepartmentID string `json:"department_id"`
+}
+
+type UnitDepartmentListResponse struct {
+	BaseResponse
+	Data struct {
+		DepartmentList []UnitDepartment `json:"departmentlist"`
+		HasMore        bool             `json:"has_more"`
+		PageToken      string           `json:"page_token"`
+	} `json:"data"`
+}
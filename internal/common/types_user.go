@@ -45,18 +45,18 @@ type Order struct {
 
 type CustomAttrGenericUser struct {
 	ID   string `json:"id"`
-	Type string `json:"type"`
+	Type int    `json:"type"`
 }
 
 type CustomAttrValue struct {
-	Text        string                `json:"text"`
-	Url         string                `json:"url"`
-	PCUrl       string                `json:"pc_url"`
-	OptionID    string                `json:"option_id"`
-	OptionValue string                `json:"option_value"`
-	Name        string                `json:"name"`
-	PictureUrl  string                `json:"picture_url"`
-	GenericUser CustomAttrGenericUser `json:"generic_user"`
+	Text        string                 `json:"text,omitempty"`
+	Url         string                 `json:"url,omitempty"`
+	PCUrl       string                 `json:"pc_url,omitempty"`
+	OptionID    string                 `json:"option_id,omitempty"`
+	OptionValue string                 `json:"option_value,omitempty"`
+	Name        string                 `json:"name,omitempty"`
+	PictureUrl  string                 `json:"picture_url,omitempty"`
+	GenericUser *CustomAttrGenericUser `json:"generic_user,omitempty"`
 }
 
 type CustomAttr struct {
@@ -91,39 +91,39 @@ type DepartmentDetail struct {
 }
 
 type User struct {
-	UnionID                 string           `json:"union_id"`
-	UserID                  string           `json:"user_id"`
-	OpenID                  string           `json:"open_id"`
-	Name                    string           `json:"name"`
-	EnName                  string           `json:"en_name"`
-	NickName                string           `json:"nick_name"`
-	Email                   string           `json:"email"`
-	Mobile                  string           `json:"mobile"`
-	MobileVisible           bool             `json:"mobile_visible"`
-	Gender                  int              `json:"gender"`
-	AvatarKey               string           `json:"avatar_key"`
-	Avatar                  AvatarInfo       `json:"avatar"`
-	Status                  UserStatus       `json:"status"`
-	DepartmentIDs           []string         `json:"department_ids"`
-	LeaderUserID            string           `json:"leader_user_id"`
-	City                    string           `json:"city"`
-	Country                 string           `json:"country"`
-	WorkStation             string           `json:"work_station"`
-	JoinTime                int64            `json:"join_time"`
-	IsTenantManager         bool             `json:"is_tenant_manager"`
-	EmployeeNo              string           `json:"employee_no"`
-	EmployeeType            int              `json:"employee_type"`
-	Orders                  []Order          `json:"orders"`
-	CustomAttrs             []CustomAttr     `json:"custom_attrs"`
-	EnterpriseEmail         string           `json:"enterprise_email"`
-	JobTitle                string           `json:"job_title"`
-	Geo                     string           `json:"geo"`
-	JobLevelID              string           `json:"job_level_id"`
-	JobFamilyID             string           `json:"job_family_id"`
-	SubscriptionIDs         []string         `json:"subscription_ids"`
-	AssignInfo              AssignInfo       `json:"assign_info"`
-	DepartmentPath          DepartmentDetail `json:"department_path"`
-	DottedLineLeaderUserIDs []string         `json:"dotted_line_leader_user_ids"`
+	UnionID                 string             `json:"union_id"`
+	UserID                  string             `json:"user_id"`
+	OpenID                  string             `json:"open_id"`
+	Name                    string             `json:"name"`
+	EnName                  string             `json:"en_name"`
+	NickName                string             `json:"nick_name"`
+	Email                   string             `json:"email"`
+	Mobile                  string             `json:"mobile"`
+	MobileVisible           bool               `json:"mobile_visible"`
+	Gender                  int                `json:"gender"`
+	AvatarKey               string             `json:"avatar_key"`
+	Avatar                  AvatarInfo         `json:"avatar"`
+	Status                  UserStatus         `json:"status"`
+	DepartmentIDs           []string           `json:"department_ids"`
+	LeaderUserID            string             `json:"leader_user_id"`
+	City                    string             `json:"city"`
+	Country                 string             `json:"country"`
+	WorkStation             string             `json:"work_station"`
+	JoinTime                int64              `json:"join_time"`
+	IsTenantManager         bool               `json:"is_tenant_manager"`
+	EmployeeNo              string             `json:"employee_no"`
+	EmployeeType            int                `json:"employee_type"`
+	Orders                  []Order            `json:"orders"`
+	CustomAttrs             []CustomAttr       `json:"custom_attrs"`
+	EnterpriseEmail         string             `json:"enterprise_email"`
+	JobTitle                string             `json:"job_title"`
+	Geo                     string             `json:"geo"`
+	JobLevelID              string             `json:"job_level_id"`
+	JobFamilyID             string             `json:"job_family_id"`
+	SubscriptionIDs         []string           `json:"subscription_ids"`
+	AssignInfo              AssignInfo         `json:"assign_info"`
+	DepartmentPath          []DepartmentDetail `json:"department_path"`
+	DottedLineLeaderUserIDs []string           `json:"dotted_line_leader_user_ids"`
 }
 
 type UserInfoBatchGetResponse struct {
@@ -132,3 +132,54 @@ type UserInfoBatchGetResponse struct {
 		Items []User `json:"items"`
 	} `json:"data"`
 }
+
+type UserListResponse struct {
+	BaseResponse
+	Data struct {
+		Items     []User `json:"items"`
+		PageToken string `json:"page_token"`
+		HasMore   bool   `json:"has_more"`
+	} `json:"data"`
+}
+
+type BaseUser struct {
+	Name          string       `json:"name"`
+	EnName        string       `json:"en_name"`
+	Email         string       `json:"email,omitempty"`
+	Mobile        string       `json:"mobile"`
+	DepartmentIDs []string     `json:"department_ids"`
+	LeaderUserID  string       `json:"leader_user_id"`
+	City          string       `json:"city"`
+	EmployeeType  int          `json:"employee_type"`
+	JobTitle      string       `json:"job_title"`
+	Orders        []Order      `json:"orders,omitempty"`
+	CustomAttrs   []CustomAttr `json:"custom_attrs,omitempty"`
+}
+
+type UserCreateRequest struct {
+	BaseUser
+	UserID string `json:"user_id,omitempty"`
+}
+
+type UserUpdateRequest struct {
+	BaseUser
+}
+
+type UserDepartmentsUpdateRequest struct {
+	DepartmentIDs []string `json:"department_ids"`
+}
+
+type UserGetResponse struct {
+	BaseResponse
+	Data struct {
+		User User `json:"user"`
+	} `json:"data"`
+}
+
+// UserDeleteRequest names the users receiving the resources of the resigned user.
+type UserDeleteRequest struct {
+	DepartmentChatAcceptorUserID string `json:"department_chat_acceptor_user_id,omitempty"`
+	ExternalChatAcceptorUserID   string `json:"external_chat_acceptor_user_id,omitempty"`
+	DocsAcceptorUserID           string `json:"docs_acceptor_user_id,omitempty"`
+	CalendarAcceptorUserID       string `json:"calendar_acceptor_user_id,omitempty"`
+}
@@ -4,7 +4,10 @@
 package common
 
 import (
+	"crypto/rand"
 	"errors"
+	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -21,6 +24,12 @@ func Contains(s string, substrs ...string) bool {
 	return false
 }
 
+// IsAPIErrorCode checks if the error wraps an APIError with any of the codes.
+func IsAPIErrorCode(err error, codes ...int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && slices.Contains(codes, apiErr.Code)
+}
+
 // splitUserAndBotList splits the user and bot list from the request.
 func splitUserAndBotList(ids []string) (botList []string, personList []string, err error) {
 	for _, id := range ids {
@@ -53,3 +62,40 @@ func StringValuesToStrings(values []basetypes.StringValue) []string {
 	}
 	return result
 }
+
+// StringsToStringValues converts a list of strings to a list of basetypes.StringValue.
+func StringsToStringValues(values []string) []basetypes.StringValue {
+	result := make([]basetypes.StringValue, 0, len(values))
+	for _, v := range values {
+		result = append(result, basetypes.NewStringValue(v))
+	}
+	return result
+}
+
+// SplitCompositeID splits an import ID in the form of "<part1>:<part2>:..." into exactly n parts.
+func SplitCompositeID(id string, n int) ([]string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != n {
+		return nil, fmt.Errorf("invalid ID %q, expected %d parts separated by \":\"", id, n)
+	}
+
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("invalid ID %q, every part must be non-empty", id)
+		}
+	}
+
+	return parts, nil
+}
+
+// NewUUID generates a random version 4 UUID.
+func NewUUID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
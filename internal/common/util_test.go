@@ -5,6 +5,8 @@ package common
 
 import (
 	"errors"
+	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -110,3 +112,96 @@ func TestSplitUserAndBotList(t *testing.T) {
 
 	}
 }
+
+func TestSplitCompositeID(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		n       int
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "success split",
+			id:   "oc_123:ou_123",
+			n:    2,
+			want: []string{"oc_123", "ou_123"},
+		},
+		{
+			name:    "error wrong number of parts",
+			id:      "oc_123",
+			n:       2,
+			wantErr: true,
+		},
+		{
+			name:    "error empty part",
+			id:      "oc_123:",
+			n:       2,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		PatchConvey(tt.name, t, func() {
+			got, err := SplitCompositeID(tt.id, tt.n)
+			if tt.wantErr {
+				So(err, ShouldNotBeNil)
+				So(got, ShouldBeNil)
+			} else {
+				So(err, ShouldBeNil)
+				So(got, ShouldResemble, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAPIErrorCode(t *testing.T) {
+	tests := []struct {
+		name  string
+		err   error
+		codes []int
+		want  bool
+	}{
+		{
+			name:  "wrapped api error with matching code",
+			err:   fmt.Errorf("API error when getting message: %w", &APIError{Code: 230011, Msg: "message recalled"}),
+			codes: []int{230011, 230110},
+			want:  true,
+		},
+		{
+			name:  "api error with another code",
+			err:   &APIError{Code: 99991400, Msg: "request trigger frequency limit"},
+			codes: []int{230011},
+			want:  false,
+		},
+		{
+			name:  "plain error",
+			err:   fmt.Errorf("error executing request: timeout"),
+			codes: []int{230011},
+			want:  false,
+		},
+		{
+			name:  "nil error",
+			codes: []int{230011},
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		PatchConvey(tt.name, t, func() {
+			So(IsAPIErrorCode(tt.err, tt.codes...), ShouldEqual, tt.want)
+		})
+	}
+}
+
+func TestNewUUID(t *testing.T) {
+	PatchConvey("generates unique version 4 UUIDs", t, func() {
+		first, err := NewUUID()
+		So(err, ShouldBeNil)
+		So(regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`).MatchString(first), ShouldBeTrue)
+
+		second, err := NewUUID()
+		So(err, ShouldBeNil)
+		So(second, ShouldNotEqual, first)
+	})
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDepartmentDataSource(t *testing.T) {
+	engineering := common.Department{
+		BaseDepartment: common.BaseDepartment{
+			Name:               "Engineering",
+			ParentDepartmentID: "0",
+			LeaderUserID:       "ou_leader",
+			Leaders:            []common.DepartmentLeader{{LeaderID: "ou_leader", LeaderType: 1}},
+		},
+		DepartmentID:     "engineering",
+		OpenDepartmentID: "od-engineering",
+		ChatID:           "oc_engineering",
+		MemberCount:      12,
+	}
+	children := map[string][]common.Department{
+		"0": {
+			engineering,
+			{BaseDepartment: common.BaseDepartment{Name: "Sales"}, OpenDepartmentID: "od-sales-1"},
+			{BaseDepartment: common.BaseDepartment{Name: "Sales"}, OpenDepartmentID: "od-sales-2"},
+		},
+	}
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.DepartmentGetAPI).To(func(ctx context.Context, client *common.LarkClient, departmentID string, departmentIDType common.DepartmentIDType) (*common.DepartmentGetResponse, error) {
+		if (departmentIDType == common.DEPARTMENT_ID && departmentID != engineering.DepartmentID) ||
+			(departmentIDType == common.OPEN_DEPARTMENT_ID && departmentID != engineering.OpenDepartmentID) {
+			return nil, fmt.Errorf("department %s not found", departmentID)
+		}
+		response := &common.DepartmentGetResponse{}
+		response.Data.Department = engineering
+		return response, nil
+	}).Build()
+	Mock(common.DepartmentChildrenListAPI).To(func(ctx context.Context, client *common.LarkClient, departmentID string, departmentIDType common.DepartmentIDType, fetchChild bool) (*common.DepartmentListResponse, error) {
+		response := &common.DepartmentListResponse{}
+		response.Data.Items = children[departmentID]
+		return response, nil
+	}).Build()
+	defer UnPatchAll()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `data "lark_department" "test" {
+					name = "Engineering"
+				}`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: providerConfig + `data "lark_department" "test" {
+					department_id = "engineering"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lark_department.test", "open_department_id", "od-engineering"),
+					resource.TestCheckResourceAttr("data.lark_department.test", "name", "Engineering"),
+					resource.TestCheckResourceAttr("data.lark_department.test", "chat_id", "oc_engineering"),
+					resource.TestCheckResourceAttr("data.lark_department.test", "member_count", "12"),
+					resource.TestCheckResourceAttr("data.lark_department.test", "leaders.0.leader_id", "ou_leader"),
+				),
+			},
+			{
+				Config: providerConfig + `data "lark_department" "test" {
+					open_department_id = "od-engineering"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lark_department.test", "department_id", "engineering"),
+				),
+			},
+			{
+				Config: providerConfig + `data "lark_department" "test" {
+					name                 = "Engineering"
+					parent_department_id = "0"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lark_department.test", "open_department_id", "od-engineering"),
+					resource.TestCheckResourceAttr("data.lark_department.test", "leader_user_id", "ou_leader"),
+				),
+			},
+			{
+				Config: providerConfig + `data "lark_department" "test" {
+					name                 = "Sales"
+					parent_department_id = "0"
+				}`,
+				ExpectError: regexp.MustCompile("Ambiguous Department Name"),
+			},
+			{
+				Config: providerConfig + `data "lark_department" "test" {
+					name                 = "Marketing"
+					parent_department_id = "0"
+				}`,
+				ExpectError: regexp.MustCompile("Department Not Found"),
+			},
+		},
+	})
+}
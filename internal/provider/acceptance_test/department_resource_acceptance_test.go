@@ -6,11 +6,14 @@ package provider_acceptance_test
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"slices"
 	"testing"
 
 	"github.com/aganisatria/terraform-provider-lark/internal/common"
 	. "github.com/bytedance/mockey"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccDepartmentResource(t *testing.T) {
@@ -107,43 +110,80 @@ func TestAccDepartmentResource(t *testing.T) {
 		},
 	}, nil).Build()
 
-	// Mock untuk update department
-	Mock(common.DepartmentUpdateAPI).Return(&common.DepartmentGetResponse{
-		Data: struct {
-			Department common.Department `json:"department"`
-		}{
-			Department: common.Department{
-				DepartmentID:     "dp_919c0000000000000000000000000000",
-				OpenDepartmentID: "od_test_department_id",
-				BaseDepartment: common.BaseDepartment{
-					Name: "Updated Test Department",
-					I18nName: common.I18nName{
-						ZhCn: "测试部门",
-						JaJp: "テスト部門",
-						EnUs: "Test Department",
+	// The department as Lark returns it, renamed by the update.
+	departmentName := "Test Department"
+	Mock(common.DepartmentGetAPI).To(func(ctx context.Context, client *common.LarkClient, departmentID string, departmentIDType common.DepartmentIDType) (*common.DepartmentGetResponse, error) {
+		response := &common.DepartmentGetResponse{}
+		response.Data.Department = common.Department{
+			DepartmentID:     "dp_919c0000000000000000000000000000",
+			OpenDepartmentID: "od_test_department_id",
+			BaseDepartment: common.BaseDepartment{
+				Name: departmentName,
+				I18nName: common.I18nName{
+					ZhCn: "测试部门",
+					JaJp: "テスト部門",
+					EnUs: "Test Department",
+				},
+				ParentDepartmentID: "0",
+				LeaderUserID:       "ou_8fc0c1843c33c130462669327fb2113c",
+				Order:              "1",
+				UnitIDs:            []string{"unit_v1_919c0000000000000000000000000000"},
+				Leaders: []common.DepartmentLeader{
+					{
+						LeaderID:   "user_v1_919c0000000000000000000000000000",
+						LeaderType: 1,
 					},
-					ParentDepartmentID: "0",
-					LeaderUserID:       "ou_8fc0c1843c33c130462669327fb2113c",
-					Order:              "1",
-					UnitIDs:            []string{"unit_v1_919c0000000000000000000000000000"},
-					CreateGroupChat:    true,
-					Leaders: []common.DepartmentLeader{
-						{
-							LeaderID:   "user_v1_919c0000000000000000000000000000",
-							LeaderType: 1,
+				},
+				GroupChatEmployeeTypes: []int64{1, 2},
+			},
+			ChatID:      "oc_test_chat_id",
+			MemberCount: 10,
+		}
+		return response, nil
+	}).Build()
+
+	// Mock untuk update department
+	Mock(common.DepartmentUpdateAPI).To(func(ctx context.Context, client *common.LarkClient, departmentID string, request common.DepartmentUpdateRequest) (*common.DepartmentGetResponse, error) {
+		departmentName = request.Name
+		return &common.DepartmentGetResponse{
+			Data: struct {
+				Department common.Department `json:"department"`
+			}{
+				Department: common.Department{
+					DepartmentID:     "dp_919c0000000000000000000000000000",
+					OpenDepartmentID: "od_test_department_id",
+					BaseDepartment: common.BaseDepartment{
+						Name: "Updated Test Department",
+						I18nName: common.I18nName{
+							ZhCn: "测试部门",
+							JaJp: "テスト部門",
+							EnUs: "Test Department",
+						},
+						ParentDepartmentID: "0",
+						LeaderUserID:       "ou_8fc0c1843c33c130462669327fb2113c",
+						Order:              "1",
+						UnitIDs:            []string{"unit_v1_919c0000000000000000000000000000"},
+						CreateGroupChat:    true,
+						Leaders: []common.DepartmentLeader{
+							{
+								LeaderID:   "user_v1_919c0000000000000000000000000000",
+								LeaderType: 1,
+							},
 						},
+						GroupChatEmployeeTypes: []int64{1, 2},
 					},
-					GroupChatEmployeeTypes: []int64{1, 2},
 				},
 			},
-		},
-	}, nil).Build()
+		}, nil
+	}).Build()
 
 	Mock(common.DepartmentDeleteAPI).Return(&common.DepartmentDeleteResponse{
 		BaseResponse: common.BaseResponse{
 			Code: 0,
 		},
 	}, nil).Build()
+	Mock(common.DepartmentChildrenListAPI).Return(&common.DepartmentListResponse{}, nil).Build()
+	Mock(common.UserListByDepartmentAPI).Return(&common.UserListResponse{}, nil).Build()
 	defer UnPatchAll()
 
 	resource.Test(t, resource.TestCase{
@@ -225,3 +265,387 @@ func TestAccDepartmentResource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDepartmentResourceDeletionPolicy(t *testing.T) {
+	child := common.Department{
+		BaseDepartment:   common.BaseDepartment{Name: "Child", ParentDepartmentID: "od_parent"},
+		DepartmentID:     "child",
+		OpenDepartmentID: "od_child",
+	}
+	user := common.User{OpenID: "ou_member", DepartmentIDs: []string{"od_parent", "od_other"}}
+	deleted := []string{}
+	parentUpdates := 0
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.DepartmentGetAPI).To(func(ctx context.Context, client *common.LarkClient, departmentID string, departmentIDType common.DepartmentIDType) (*common.DepartmentGetResponse, error) {
+		if departmentIDType != common.OPEN_DEPARTMENT_ID || departmentID != "od_parent" {
+			return nil, fmt.Errorf("API error when getting department: department not found")
+		}
+		response := &common.DepartmentGetResponse{}
+		response.Data.Department = common.Department{
+			BaseDepartment:   common.BaseDepartment{Name: "Parent", ParentDepartmentID: "0"},
+			DepartmentID:     "parent",
+			OpenDepartmentID: "od_parent",
+		}
+		return response, nil
+	}).Build()
+	Mock(common.DepartmentCreateAPI).To(func(ctx context.Context, client *common.LarkClient, request common.DepartmentCreateRequest) (*common.DepartmentGetResponse, error) {
+		response := &common.DepartmentGetResponse{}
+		response.Data.Department = common.Department{
+			BaseDepartment:   request.BaseDepartment,
+			DepartmentID:     "parent",
+			OpenDepartmentID: "od_parent",
+		}
+		return response, nil
+	}).Build()
+	Mock(common.DepartmentUpdateAPI).To(func(ctx context.Context, client *common.LarkClient, departmentID string, request common.DepartmentUpdateRequest) (*common.DepartmentGetResponse, error) {
+		if departmentID == child.OpenDepartmentID {
+			child.BaseDepartment = request.BaseDepartment
+		}
+		if departmentID == "od_parent" {
+			parentUpdates++
+		}
+		response := &common.DepartmentGetResponse{}
+		response.Data.Department = common.Department{
+			BaseDepartment:   request.BaseDepartment,
+			DepartmentID:     "parent",
+			OpenDepartmentID: departmentID,
+		}
+		return response, nil
+	}).Build()
+	Mock(common.DepartmentChildrenListAPI).To(func(ctx context.Context, client *common.LarkClient, departmentID string, departmentIDType common.DepartmentIDType, fetchChild bool) (*common.DepartmentListResponse, error) {
+		response := &common.DepartmentListResponse{}
+		if child.ParentDepartmentID == departmentID {
+			response.Data.Items = []common.Department{child}
+		}
+		return response, nil
+	}).Build()
+	Mock(common.UserListByDepartmentAPI).To(func(ctx context.Context, client *common.LarkClient, departmentID string, departmentIDType common.DepartmentIDType, userIDType common.UserIDType) (*common.UserListResponse, error) {
+		response := &common.UserListResponse{}
+		if slices.Contains(user.DepartmentIDs, departmentID) {
+			response.Data.Items = []common.User{user}
+		}
+		return response, nil
+	}).Build()
+	Mock(common.UserUpdateDepartmentsAPI).To(func(ctx context.Context, client *common.LarkClient, openID string, departmentIDs []string) (*common.UserGetResponse, error) {
+		user.DepartmentIDs = departmentIDs
+		response := &common.UserGetResponse{}
+		response.Data.User = user
+		return response, nil
+	}).Build()
+	Mock(common.DepartmentDeleteAPI).To(func(ctx context.Context, client *common.LarkClient, departmentID string) (*common.DepartmentDeleteResponse, error) {
+		if child.ParentDepartmentID == departmentID || slices.Contains(user.DepartmentIDs, departmentID) {
+			return nil, fmt.Errorf("API error when deleting department: department is not empty")
+		}
+		deleted = append(deleted, departmentID)
+		return &common.DepartmentDeleteResponse{}, nil
+	}).Build()
+	defer UnPatchAll()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if !slices.Equal(deleted, []string{"od_parent"}) {
+				return fmt.Errorf("expected od_parent to be deleted, got %v", deleted)
+			}
+			if child.ParentDepartmentID != "0" {
+				return fmt.Errorf("child department was not moved to the parent, got %s", child.ParentDepartmentID)
+			}
+			if !slices.Equal(user.DepartmentIDs, []string{"od_other", "0"}) {
+				return fmt.Errorf("user was not moved to the parent, got %v", user.DepartmentIDs)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "lark_department" "test" {
+					name                 = "Parent"
+					parent_department_id = "0"
+					department_id        = "parent"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_department.test", "deletion_policy", "fail"),
+				),
+			},
+			{
+				Config: providerConfig + `
+				resource "lark_department" "test" {
+					name                 = "Parent"
+					parent_department_id = "0"
+					department_id        = "parent"
+					deletion_policy      = "move_members_to_parent"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_department.test", "deletion_policy", "move_members_to_parent"),
+					func(s *terraform.State) error {
+						if parentUpdates != 0 {
+							return fmt.Errorf("expected no department update when only deletion_policy changes, got %d", parentUpdates)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccDepartmentResourceDeletionPolicyAbandon(t *testing.T) {
+	deleted := false
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.DepartmentGetAPI).To(func(ctx context.Context, client *common.LarkClient, departmentID string, departmentIDType common.DepartmentIDType) (*common.DepartmentGetResponse, error) {
+		if departmentIDType != common.OPEN_DEPARTMENT_ID || departmentID != "od_abandoned" {
+			return nil, fmt.Errorf("API error when getting department: department not found")
+		}
+		response := &common.DepartmentGetResponse{}
+		response.Data.Department = common.Department{
+			BaseDepartment:   common.BaseDepartment{Name: "Abandoned", ParentDepartmentID: "0"},
+			DepartmentID:     "abandoned",
+			OpenDepartmentID: "od_abandoned",
+		}
+		return response, nil
+	}).Build()
+	Mock(common.DepartmentCreateAPI).To(func(ctx context.Context, client *common.LarkClient, request common.DepartmentCreateRequest) (*common.DepartmentGetResponse, error) {
+		response := &common.DepartmentGetResponse{}
+		response.Data.Department = common.Department{
+			BaseDepartment:   request.BaseDepartment,
+			DepartmentID:     "abandoned",
+			OpenDepartmentID: "od_abandoned",
+		}
+		return response, nil
+	}).Build()
+	Mock(common.DepartmentChildrenListAPI).Return(&common.DepartmentListResponse{}, nil).Build()
+	Mock(common.UserListByDepartmentAPI).Return(&common.UserListResponse{}, nil).Build()
+	Mock(common.DepartmentDeleteAPI).To(func(ctx context.Context, client *common.LarkClient, departmentID string) (*common.DepartmentDeleteResponse, error) {
+		deleted = true
+		return &common.DepartmentDeleteResponse{}, nil
+	}).Build()
+	defer UnPatchAll()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if deleted {
+				return fmt.Errorf("abandoned department was deleted")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "lark_department" "test" {
+					name                 = "Abandoned"
+					parent_department_id = "0"
+					deletion_policy      = "abandon"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_department.test", "deletion_policy", "abandon"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDepartmentResourceRefresh(t *testing.T) {
+	department := common.Department{
+		BaseDepartment: common.BaseDepartment{
+			Name:               "Refresh",
+			ParentDepartmentID: "0",
+			Order:              "1",
+		},
+		DepartmentID:     "refresh",
+		OpenDepartmentID: "od_refresh",
+	}
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.GetUsersByIDAPI).Return(&common.UserInfoBatchGetResponse{}, nil).Build()
+	var getErr error
+	Mock(common.DepartmentGetAPI).To(func(ctx context.Context, client *common.LarkClient, departmentID string, departmentIDType common.DepartmentIDType) (*common.DepartmentGetResponse, error) {
+		if getErr != nil {
+			return nil, getErr
+		}
+		if departmentIDType != common.OPEN_DEPARTMENT_ID || departmentID != department.OpenDepartmentID {
+			return nil, fmt.Errorf("API error when getting department: %w", &common.APIError{Code: common.DEPARTMENT_NOT_FOUND_CODE, Msg: "department not found"})
+		}
+		response := &common.DepartmentGetResponse{}
+		response.Data.Department = department
+		return response, nil
+	}).Build()
+	Mock(common.DepartmentCreateAPI).To(func(ctx context.Context, client *common.LarkClient, request common.DepartmentCreateRequest) (*common.DepartmentGetResponse, error) {
+		department.BaseDepartment = request.BaseDepartment
+		response := &common.DepartmentGetResponse{}
+		response.Data.Department = department
+		return response, nil
+	}).Build()
+	Mock(common.DepartmentChildrenListAPI).Return(&common.DepartmentListResponse{}, nil).Build()
+	Mock(common.UserListByDepartmentAPI).Return(&common.UserListResponse{}, nil).Build()
+	Mock(common.DepartmentDeleteAPI).Return(&common.DepartmentDeleteResponse{}, nil).Build()
+	defer UnPatchAll()
+
+	config := providerConfig + `
+	resource "lark_department" "test" {
+		name                 = "Refresh"
+		parent_department_id = "0"
+		department_id        = "refresh"
+		order                = "1"
+	}
+	`
+	leaderConfig := providerConfig + `
+	resource "lark_department" "test" {
+		name                 = "Refresh"
+		parent_department_id = "0"
+		department_id        = "refresh"
+		order                = "1"
+		leader_user_id       = "ou_leader"
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_department.test", "open_department_id", "od_refresh"),
+					resource.TestCheckNoResourceAttr("lark_department.test", "leaders.#"),
+				),
+			},
+			// Renamed and reordered in the admin console.
+			{
+				PreConfig: func() {
+					department.Name = "Renamed"
+					department.Order = "2"
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Leader set in the admin console.
+			{
+				PreConfig: func() {
+					department.Name = "Refresh"
+					department.Order = "1"
+					department.LeaderUserID = "ou_leader"
+					department.Leaders = []common.DepartmentLeader{{LeaderType: 1, LeaderID: "ou_leader"}}
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// The leaders Lark fills in from leader_user_id are not managed.
+			{
+				Config:   leaderConfig,
+				PlanOnly: true,
+			},
+			// A failed read keeps the department in the state.
+			{
+				PreConfig: func() {
+					department.LeaderUserID = ""
+					department.Leaders = nil
+					getErr = &common.APIError{Code: 99991400, Msg: "request trigger frequency limit"}
+				},
+				Config:      config,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("API Error Reading Department"),
+			},
+			// Not found in Lark.
+			{
+				PreConfig: func() {
+					getErr = fmt.Errorf("API error when getting department: %w", &common.APIError{Code: common.DEPARTMENT_NOT_FOUND_CODE, Msg: "department not found"})
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Deleted in the admin console.
+			{
+				PreConfig: func() {
+					getErr = nil
+					department.Status.IsDeleted = true
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				PreConfig: func() {
+					department.Status.IsDeleted = false
+				},
+				Config: config,
+			},
+		},
+	})
+}
+
+func TestAccDepartmentResourceDepartmentIDType(t *testing.T) {
+	parent := common.Department{
+		BaseDepartment:   common.BaseDepartment{Name: "Parent", ParentDepartmentID: "0"},
+		DepartmentID:     "parent",
+		OpenDepartmentID: "od_parent",
+	}
+	departments := []common.Department{parent}
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.DepartmentGetAPI).To(func(ctx context.Context, client *common.LarkClient, departmentID string, departmentIDType common.DepartmentIDType) (*common.DepartmentGetResponse, error) {
+		for _, department := range departments {
+			if (departmentIDType == common.DEPARTMENT_ID && department.DepartmentID == departmentID) ||
+				(departmentIDType == common.OPEN_DEPARTMENT_ID && department.OpenDepartmentID == departmentID) {
+				response := &common.DepartmentGetResponse{}
+				response.Data.Department = department
+				return response, nil
+			}
+		}
+		return nil, fmt.Errorf("API error when getting department: department not found")
+	}).Build()
+	Mock(common.DepartmentCreateAPI).To(func(ctx context.Context, client *common.LarkClient, request common.DepartmentCreateRequest) (*common.DepartmentGetResponse, error) {
+		if request.ParentDepartmentID != parent.OpenDepartmentID {
+			return nil, fmt.Errorf("API error when creating department: parent department not found")
+		}
+		department := common.Department{
+			BaseDepartment:   request.BaseDepartment,
+			DepartmentID:     request.DepartmentID,
+			OpenDepartmentID: "od_child",
+		}
+		departments = append(departments, department)
+		response := &common.DepartmentGetResponse{}
+		response.Data.Department = department
+		return response, nil
+	}).Build()
+	Mock(common.DepartmentChildrenListAPI).Return(&common.DepartmentListResponse{}, nil).Build()
+	Mock(common.UserListByDepartmentAPI).Return(&common.UserListResponse{}, nil).Build()
+	Mock(common.DepartmentDeleteAPI).Return(&common.DepartmentDeleteResponse{}, nil).Build()
+	defer UnPatchAll()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "lark_department" "test" {
+					name                 = "Child"
+					parent_department_id = "parent"
+					department_id_type   = "department_id"
+					department_id        = "child"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_department.test", "parent_department_id", "parent"),
+					resource.TestCheckResourceAttr("lark_department.test", "department_id", "child"),
+					resource.TestCheckResourceAttr("lark_department.test", "open_department_id", "od_child"),
+				),
+			},
+			{
+				ResourceName:                         "lark_department.test",
+				ImportState:                          true,
+				ImportStateId:                        "department_id/child",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "department_id",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+		},
+	})
+}
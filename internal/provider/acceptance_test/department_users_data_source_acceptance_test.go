@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDepartmentUsersDataSource(t *testing.T) {
+	departmentUsers := map[string][]common.User{
+		"od-parent": {
+			{OpenID: "ou_parent", UserID: "u_parent", Name: "Parent", EmployeeType: 1},
+			{OpenID: "ou_frozen", Name: "Frozen", EmployeeType: 1, Status: common.UserStatus{IsFrozen: true}},
+		},
+		"od-child": {
+			// Users may belong to several departments.
+			{OpenID: "ou_parent", UserID: "u_parent", Name: "Parent", EmployeeType: 1},
+			{OpenID: "ou_intern", Name: "Intern", Email: "intern@example.com", EmployeeType: 2},
+			{OpenID: "ou_resigned", Name: "Resigned", EmployeeType: 1, Status: common.UserStatus{IsResigned: true}},
+		},
+	}
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.DepartmentChildrenListAPI).To(func(ctx context.Context, client *common.LarkClient, departmentID string, departmentIDType common.DepartmentIDType, fetchChild bool) (*common.DepartmentListResponse, error) {
+		response := &common.DepartmentListResponse{}
+		if departmentID == "od-parent" {
+			response.Data.Items = []common.Department{{OpenDepartmentID: "od-child"}}
+		}
+		return response, nil
+	}).Build()
+	Mock(common.UserListByDepartmentAPI).To(func(ctx context.Context, client *common.LarkClient, departmentID string, departmentIDType common.DepartmentIDType, userIDType common.UserIDType) (*common.UserListResponse, error) {
+		response := &common.UserListResponse{}
+		response.Data.Items = departmentUsers[departmentID]
+		return response, nil
+	}).Build()
+	defer UnPatchAll()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `data "lark_department_users" "test" {
+					department_id = "od-parent"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lark_department_users.test", "recursive", "false"),
+					resource.TestCheckResourceAttr("data.lark_department_users.test", "users.#", "2"),
+					resource.TestCheckResourceAttr("data.lark_department_users.test", "users.0.open_id", "ou_parent"),
+					resource.TestCheckResourceAttr("data.lark_department_users.test", "users.0.user_id", "u_parent"),
+				),
+			},
+			{
+				Config: providerConfig + `data "lark_department_users" "test" {
+					department_id = "od-parent"
+					recursive     = true
+					status        = "active"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lark_department_users.test", "users.#", "2"),
+					resource.TestCheckResourceAttr("data.lark_department_users.test", "users.0.open_id", "ou_parent"),
+					resource.TestCheckResourceAttr("data.lark_department_users.test", "users.1.open_id", "ou_intern"),
+					resource.TestCheckResourceAttr("data.lark_department_users.test", "users.1.email", "intern@example.com"),
+				),
+			},
+			{
+				Config: providerConfig + `data "lark_department_users" "test" {
+					department_id  = "od-parent"
+					recursive      = true
+					employee_types = [1]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lark_department_users.test", "users.#", "3"),
+					resource.TestCheckResourceAttr("data.lark_department_users.test", "users.2.open_id", "ou_resigned"),
+				),
+			},
+		},
+	})
+}
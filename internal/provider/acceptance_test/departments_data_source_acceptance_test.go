@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDepartmentsDataSource(t *testing.T) {
+	engineering := common.Department{
+		BaseDepartment:   common.BaseDepartment{Name: "Engineering", ParentDepartmentID: "0"},
+		OpenDepartmentID: "od-engineering",
+		ChatID:           "oc_engineering",
+		MemberCount:      12,
+	}
+	platform := common.Department{
+		BaseDepartment: common.BaseDepartment{
+			Name:               "Platform",
+			ParentDepartmentID: "od-engineering",
+			Leaders:            []common.DepartmentLeader{{LeaderID: "ou_leader", LeaderType: 1}},
+		},
+		OpenDepartmentID: "od-platform",
+		MemberCount:      5,
+	}
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.DepartmentChildrenListAPI).To(func(ctx context.Context, client *common.LarkClient, departmentID string, departmentIDType common.DepartmentIDType, fetchChild bool) (*common.DepartmentListResponse, error) {
+		response := &common.DepartmentListResponse{}
+		response.Data.Items = []common.Department{engineering}
+		if fetchChild {
+			response.Data.Items = append(response.Data.Items, platform)
+		}
+		return response, nil
+	}).Build()
+	defer UnPatchAll()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `data "lark_departments" "test" {
+					parent_department_id = "0"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lark_departments.test", "recursive", "false"),
+					resource.TestCheckResourceAttr("data.lark_departments.test", "departments.#", "1"),
+					resource.TestCheckResourceAttr("data.lark_departments.test", "departments.0.open_department_id", "od-engineering"),
+					resource.TestCheckResourceAttr("data.lark_departments.test", "departments.0.chat_id", "oc_engineering"),
+					resource.TestCheckResourceAttr("data.lark_departments.test", "departments.0.member_count", "12"),
+				),
+			},
+			{
+				Config: providerConfig + `data "lark_departments" "test" {
+					parent_department_id = "0"
+					recursive            = true
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lark_departments.test", "departments.#", "2"),
+					resource.TestCheckResourceAttr("data.lark_departments.test", "departments.1.parent_department_id", "od-engineering"),
+					resource.TestCheckResourceAttr("data.lark_departments.test", "departments.1.leaders.0.leader_id", "ou_leader"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccGroupChatAnnouncementResource(t *testing.T) {
+	revision := 1
+	document := common.AnnouncementDocument{}
+
+	textDocument := func(lines ...string) common.AnnouncementDocument {
+		result := common.AnnouncementDocument{}
+		for _, line := range lines {
+			result.Body.Blocks = append(result.Body.Blocks, common.AnnouncementBlock{
+				Type: "paragraph",
+				Paragraph: &common.AnnouncementParagraph{
+					Elements: []common.AnnouncementElement{
+						{Type: "textRun", TextRun: &common.AnnouncementTextRun{Text: line}},
+					},
+				},
+			})
+		}
+		return result
+	}
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.GroupChatAnnouncementGetAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string) (*common.GroupChatAnnouncementGetResponse, error) {
+		content, err := json.Marshal(document)
+		if err != nil {
+			return nil, err
+		}
+		response := &common.GroupChatAnnouncementGetResponse{}
+		response.Data.Content = string(content)
+		response.Data.Revision = strconv.Itoa(revision)
+		return response, nil
+	}).Build()
+	Mock(common.GroupChatAnnouncementUpdateAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string, req common.GroupChatAnnouncementUpdateRequest) (*common.BaseResponse, error) {
+		if req.Revision != strconv.Itoa(revision) {
+			return nil, fmt.Errorf("unexpected revision %s, current revision is %d", req.Revision, revision)
+		}
+		for _, rawRequest := range req.Requests {
+			change := common.AnnouncementChangeRequest{}
+			if err := json.Unmarshal([]byte(rawRequest), &change); err != nil {
+				return nil, err
+			}
+			switch change.RequestType {
+			case "DeleteContentRangeRequestType":
+				document.Body.Blocks = nil
+			case "InsertBlocksRequestType":
+				body := common.AnnouncementBody{}
+				if err := json.Unmarshal([]byte(change.InsertBlocksRequest.Payload), &body); err != nil {
+					return nil, err
+				}
+				document.Body.Blocks = append(document.Body.Blocks, body.Blocks...)
+			default:
+				return nil, fmt.Errorf("unexpected request type %s", change.RequestType)
+			}
+		}
+		revision++
+		return &common.BaseResponse{}, nil
+	}).Build()
+	defer UnPatchAll()
+
+	expectServerText := func(want ...string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			if len(document.Body.Blocks) != len(want) {
+				return fmt.Errorf("expected %d paragraphs, got %d", len(want), len(document.Body.Blocks))
+			}
+			for i, line := range want {
+				if got := document.Body.Blocks[i].Paragraph.Elements[0].TextRun.Text; got != line {
+					return fmt.Errorf("expected paragraph %d to be %q, got %q", i, line, got)
+				}
+			}
+			return nil
+		}
+	}
+
+	plainTextConfig := providerConfig + `
+	resource "lark_group_chat_announcement" "example" {
+		group_chat_id = "oc_test"
+		content       = "hello\nworld"
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if len(document.Body.Blocks) != 0 {
+				return fmt.Errorf("expected the announcement to be cleared, got %d paragraphs", len(document.Body.Blocks))
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read Testing
+			{
+				Config: plainTextConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_group_chat_announcement.example", "content", "hello\nworld"),
+					resource.TestCheckResourceAttr("lark_group_chat_announcement.example", "content_format", "plain_text"),
+					resource.TestCheckResourceAttr("lark_group_chat_announcement.example", "revision", "2"),
+					expectServerText("hello", "world"),
+				),
+			},
+			// ImportState Testing
+			{
+				ResourceName:                         "lark_group_chat_announcement.example",
+				ImportState:                          true,
+				ImportStateId:                        "oc_test",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "group_chat_id",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+			// Edited in the UI, terraform writes the configured content back
+			{
+				PreConfig: func() {
+					document = textDocument("edited in the UI")
+					revision++
+				},
+				Config: plainTextConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_group_chat_announcement.example", "content", "hello\nworld"),
+					resource.TestCheckResourceAttr("lark_group_chat_announcement.example", "revision", "4"),
+					expectServerText("hello", "world"),
+				),
+			},
+			// Markdown Testing
+			{
+				Config: providerConfig + `
+				resource "lark_group_chat_announcement" "example" {
+					group_chat_id  = "oc_test"
+					content_format = "markdown"
+					content        = "# Welcome\n\n- read the **guide**"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_group_chat_announcement.example", "content_format", "markdown"),
+					expectServerText("Welcome", "read the "),
+					func(s *terraform.State) error {
+						heading := document.Body.Blocks[0].Paragraph
+						if heading.Style.HeadingLevel != 1 {
+							return fmt.Errorf("expected a level 1 heading, got %d", heading.Style.HeadingLevel)
+						}
+						item := document.Body.Blocks[1].Paragraph
+						if item.Style.List == nil || item.Style.List.Type != "bullet" {
+							return fmt.Errorf("expected a bullet list item")
+						}
+						if len(item.Elements) != 2 || item.Elements[1].TextRun.Text != "guide" || !item.Elements[1].TextRun.Style.Bold {
+							return fmt.Errorf("expected guide to be bold")
+						}
+						return nil
+					},
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGroupChatDataSource(t *testing.T) {
+	chats := map[string]string{
+		"oc_oncall":   "On-call",
+		"oc_oncall_2": "On-call 2",
+		"oc_ops_1":    "Ops",
+		"oc_ops_2":    "Ops",
+	}
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.GroupChatSearchAPI).To(func(ctx context.Context, client *common.LarkClient, query string) (*common.GroupChatListResponse, error) {
+		response := &common.GroupChatListResponse{}
+		for _, chatID := range []string{"oc_oncall", "oc_oncall_2", "oc_ops_1", "oc_ops_2"} {
+			if regexp.MustCompile(regexp.QuoteMeta(query)).MatchString(chats[chatID]) {
+				response.Data.Items = append(response.Data.Items, common.GroupChatListItem{ChatID: chatID, Name: chats[chatID]})
+			}
+		}
+		return response, nil
+	}).Build()
+	Mock(common.GroupChatGetAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string) (*common.GroupChatGetResponse, error) {
+		name, ok := chats[chatID]
+		if !ok {
+			return nil, fmt.Errorf("chat %s not found", chatID)
+		}
+		response := &common.GroupChatGetResponse{}
+		response.Data.Name = name
+		response.Data.Description = "Team chat"
+		response.Data.I18nNames.EnUs = name
+		response.Data.OwnerID = "ou_owner"
+		response.Data.OwnerIDType = "open_id"
+		response.Data.ChatType = "private"
+		response.Data.AddMemberPermission = "all_members"
+		return response, nil
+	}).Build()
+	defer UnPatchAll()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Lookup by exact name, ignoring partial matches
+			{
+				Config: providerConfig + `data "lark_group_chat" "test" {
+					name = "On-call"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lark_group_chat.test", "chat_id", "oc_oncall"),
+					resource.TestCheckResourceAttr("data.lark_group_chat.test", "description", "Team chat"),
+					resource.TestCheckResourceAttr("data.lark_group_chat.test", "i18n_names.en_us", "On-call"),
+					resource.TestCheckResourceAttr("data.lark_group_chat.test", "owner_id", "ou_owner"),
+					resource.TestCheckResourceAttr("data.lark_group_chat.test", "chat_type", "private"),
+					resource.TestCheckResourceAttr("data.lark_group_chat.test", "add_member_permission", "all_members"),
+				),
+			},
+			// Lookup by chat ID
+			{
+				Config: providerConfig + `data "lark_group_chat" "test" {
+					chat_id = "oc_ops_1"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lark_group_chat.test", "name", "Ops"),
+				),
+			},
+			// Ambiguous name
+			{
+				Config: providerConfig + `data "lark_group_chat" "test" {
+					name = "Ops"
+				}`,
+				ExpectError: regexp.MustCompile("Ambiguous Group Chat Name"),
+			},
+			// Unknown name
+			{
+				Config: providerConfig + `data "lark_group_chat" "test" {
+					name = "Sales"
+				}`,
+				ExpectError: regexp.MustCompile("Group Chat Not Found"),
+			},
+		},
+	})
+}
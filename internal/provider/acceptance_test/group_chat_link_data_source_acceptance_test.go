@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGroupChatLinkDataSource(t *testing.T) {
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.GroupChatLinkAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string, req common.GroupChatLinkRequest) (*common.GroupChatLinkResponse, error) {
+		response := &common.GroupChatLinkResponse{}
+		response.Data.ShareLink = "https://applink.larksuite.com/client/chat/chatter/add_by_link?link_token=" + chatID + "_" + req.ValidityPeriod
+		if req.ValidityPeriod == "permanently" {
+			response.Data.IsPermanent = true
+		} else {
+			response.Data.ExpireTime = "1609296809"
+		}
+		return response, nil
+	}).Build()
+	defer UnPatchAll()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `data "lark_group_chat_link" "test" {
+					group_chat_id = "oc_test"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lark_group_chat_link.test", "validity_period", "week"),
+					resource.TestCheckResourceAttr("data.lark_group_chat_link.test", "share_link", "https://applink.larksuite.com/client/chat/chatter/add_by_link?link_token=oc_test_week"),
+					resource.TestCheckResourceAttr("data.lark_group_chat_link.test", "expire_time", "1609296809"),
+					resource.TestCheckResourceAttr("data.lark_group_chat_link.test", "is_permanent", "false"),
+				),
+			},
+			{
+				Config: providerConfig + `data "lark_group_chat_link" "test" {
+					group_chat_id   = "oc_test"
+					validity_period = "permanently"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lark_group_chat_link.test", "share_link", "https://applink.larksuite.com/client/chat/chatter/add_by_link?link_token=oc_test_permanently"),
+					resource.TestCheckResourceAttr("data.lark_group_chat_link.test", "expire_time", ""),
+					resource.TestCheckResourceAttr("data.lark_group_chat_link.test", "is_permanent", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccGroupChatMemberBindingResource(t *testing.T) {
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.GetUsersByIDAPI).Return(&common.UserInfoBatchGetResponse{
+		Data: struct {
+			Items []common.User `json:"items"`
+		}{
+			Items: []common.User{
+				{UserID: "0"},
+			},
+		},
+	}, nil).Build()
+
+	// ou_other is managed by someone else and must never be touched.
+	memberList := []string{"ou_other"}
+	Mock(common.GroupChatMemberAddAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string, req common.GroupChatMemberRequest) (*common.GroupChatMemberAddResponse, error) {
+		memberList = append(memberList, req.IDList...)
+		return &common.GroupChatMemberAddResponse{}, nil
+	}).Build()
+
+	Mock(common.GroupChatMemberDeleteAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string, req common.GroupChatMemberRequest) (*common.GroupChatMemberRemoveResponse, error) {
+		memberList = slices.DeleteFunc(memberList, func(member string) bool {
+			return slices.Contains(req.IDList, member)
+		})
+		return &common.GroupChatMemberRemoveResponse{}, nil
+	}).Build()
+
+	Mock(common.GroupChatMemberGetAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string) (*common.GroupChatMemberGetResponse, error) {
+		response := &common.GroupChatMemberGetResponse{}
+		for _, member := range memberList {
+			response.Data.Items = append(response.Data.Items, common.ListMember{MemberID: member})
+		}
+		return response, nil
+	}).Build()
+	defer UnPatchAll()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read Testing
+			{
+				Config: providerConfig + `resource "lark_group_chat_member_binding" "test" {
+					group_chat_id = "gc_test"
+					member_id     = "ou_0"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_group_chat_member_binding.test", "group_chat_id", "gc_test"),
+					resource.TestCheckResourceAttr("lark_group_chat_member_binding.test", "member_id", "ou_0"),
+				),
+			},
+
+			// Import Testing
+			{
+				ResourceName:            "lark_group_chat_member_binding.test",
+				ImportState:             true,
+				ImportStateId:           "gc_test:ou_0",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"id", "last_updated"},
+			},
+
+			// Replace and Read Testing
+			{
+				Config: providerConfig + `resource "lark_group_chat_member_binding" "test" {
+					group_chat_id = "gc_test"
+					member_id     = "ou_1"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_group_chat_member_binding.test", "group_chat_id", "gc_test"),
+					resource.TestCheckResourceAttr("lark_group_chat_member_binding.test", "member_id", "ou_1"),
+					func(s *terraform.State) error {
+						if !slices.Contains(memberList, "ou_other") {
+							return fmt.Errorf("member ou_other should not be removed from the group chat")
+						}
+						return nil
+					},
+				),
+			},
+
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const movedProviderConfig = `
+terraform {
+	required_providers {
+		lark = {
+			source = "aganisatria/lark"
+		}
+	}
+}
+` + providerConfig
+
+func TestAccGroupChatMemberBindingResourceMoveState(t *testing.T) {
+	// Moves are only accepted from this provider, so it is served under its published address.
+	t.Setenv("TF_ACC_PROVIDER_NAMESPACE", "aganisatria")
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.GetUsersByIDAPI).Return(&common.UserInfoBatchGetResponse{
+		Data: struct {
+			Items []common.User `json:"items"`
+		}{
+			Items: []common.User{
+				{UserID: "0"},
+			},
+		},
+	}, nil).Build()
+
+	memberList := []string{}
+	addCalls := 0
+	Mock(common.GroupChatMemberAddAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string, req common.GroupChatMemberRequest) (*common.GroupChatMemberAddResponse, error) {
+		addCalls++
+		for _, member := range req.IDList {
+			if !slices.Contains(memberList, member) {
+				memberList = append(memberList, member)
+			}
+		}
+		return &common.GroupChatMemberAddResponse{}, nil
+	}).Build()
+
+	Mock(common.GroupChatMemberDeleteAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string, req common.GroupChatMemberRequest) (*common.GroupChatMemberRemoveResponse, error) {
+		memberList = slices.DeleteFunc(memberList, func(member string) bool {
+			return slices.Contains(req.IDList, member)
+		})
+		return &common.GroupChatMemberRemoveResponse{}, nil
+	}).Build()
+
+	administratorDeleteCalls := 0
+	Mock(common.GroupChatAdministratorAddAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string, req common.GroupChatAdministratorRequest) (*common.GroupChatAdministratorResponse, error) {
+		return &common.GroupChatAdministratorResponse{
+			Data: struct {
+				ChatManagers    []string `json:"chat_managers"`
+				ChatBotManagers []string `json:"chat_bot_managers"`
+			}{
+				ChatManagers:    req.ManagerIDs,
+				ChatBotManagers: []string{},
+			},
+		}, nil
+	}).Build()
+	Mock(common.GroupChatAdministratorDeleteAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string, req common.GroupChatAdministratorRequest) (*common.GroupChatAdministratorResponse, error) {
+		administratorDeleteCalls++
+		return &common.GroupChatAdministratorResponse{}, nil
+	}).Build()
+
+	Mock(common.GroupChatMemberGetAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string) (*common.GroupChatMemberGetResponse, error) {
+		response := &common.GroupChatMemberGetResponse{}
+		for _, member := range memberList {
+			response.Data.Items = append(response.Data.Items, common.ListMember{MemberID: member})
+		}
+		return response, nil
+	}).Build()
+	defer UnPatchAll()
+
+	var addCallsBeforeMove int
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			// Create the authoritative member list
+			{
+				Config: movedProviderConfig + `resource "lark_group_chat_member" "test" {
+					group_chat_id     = "gc_test"
+					member_ids        = ["ou_0", "ou_1"]
+					administrator_ids = ["ou_1"]
+				}
+				`,
+				Check: func(s *terraform.State) error {
+					addCallsBeforeMove = addCalls
+					return nil
+				},
+			},
+
+			// Move one member of the list to a binding without calling the API
+			{
+				Config: movedProviderConfig + `resource "lark_group_chat_member_binding" "test" {
+					group_chat_id = "gc_test"
+					member_id     = "ou_0"
+				}
+
+				moved {
+					from = lark_group_chat_member.test
+					to   = lark_group_chat_member_binding.test
+				}
+				`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("lark_group_chat_member_binding.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_group_chat_member_binding.test", "group_chat_id", "gc_test"),
+					resource.TestCheckResourceAttr("lark_group_chat_member_binding.test", "member_id", "ou_0"),
+					func(s *terraform.State) error {
+						if addCalls != addCallsBeforeMove {
+							return fmt.Errorf("expected no add member call during the move, got %d", addCalls-addCallsBeforeMove)
+						}
+						if administratorDeleteCalls != 0 {
+							return fmt.Errorf("expected the administrators to be left alone during the move, got %d delete calls", administratorDeleteCalls)
+						}
+						return nil
+					},
+				),
+			},
+
+			// Move the binding back to an authoritative member list
+			{
+				Config: movedProviderConfig + `resource "lark_group_chat_member" "test" {
+					group_chat_id = "gc_test"
+					member_ids    = ["ou_0"]
+				}
+
+				moved {
+					from = lark_group_chat_member_binding.test
+					to   = lark_group_chat_member.test
+				}
+				`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("lark_group_chat_member.test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_group_chat_member.test", "group_chat_id", "gc_test"),
+					resource.TestCheckResourceAttr("lark_group_chat_member.test", "member_ids.#", "1"),
+					resource.TestCheckResourceAttr("lark_group_chat_member.test", "member_ids.0", "ou_0"),
+				),
+			},
+
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -5,11 +5,16 @@ package provider_acceptance_test
 
 import (
 	"context"
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aganisatria/terraform-provider-lark/internal/common"
 	. "github.com/bytedance/mockey"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 func TestAccGroupChatMemberResource(t *testing.T) {
@@ -148,3 +153,200 @@ func TestAccGroupChatMemberResource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccGroupChatMemberResourceSourceDepartments(t *testing.T) {
+	chatMembers := map[string]bool{}
+	departmentUsers := map[string][]common.User{
+		"od-parent": {{OpenID: "ou_parent", EmployeeType: 1}},
+		"od-child": {
+			{OpenID: "ou_child", EmployeeType: 1},
+			{OpenID: "ou_intern", EmployeeType: 2},
+			{OpenID: "ou_resigned", EmployeeType: 1, Status: common.UserStatus{IsResigned: true}},
+		},
+	}
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.GetUsersByIDAPI).Return(&common.UserInfoBatchGetResponse{
+		Data: struct {
+			Items []common.User `json:"items"`
+		}{
+			Items: []common.User{
+				{UserID: "0"},
+			},
+		},
+	}, nil).Build()
+	Mock(common.DepartmentChildrenListAPI).To(func(ctx context.Context, client *common.LarkClient, departmentID string, departmentIDType common.DepartmentIDType, fetchChild bool) (*common.DepartmentListResponse, error) {
+		response := &common.DepartmentListResponse{}
+		if departmentID == "od-parent" {
+			response.Data.Items = []common.Department{{OpenDepartmentID: "od-child"}}
+		}
+		return response, nil
+	}).Build()
+	Mock(common.UserListByDepartmentAPI).To(func(ctx context.Context, client *common.LarkClient, departmentID string, departmentIDType common.DepartmentIDType, userIDType common.UserIDType) (*common.UserListResponse, error) {
+		response := &common.UserListResponse{}
+		response.Data.Items = departmentUsers[departmentID]
+		return response, nil
+	}).Build()
+	Mock(common.GroupChatMemberAddAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string, req common.GroupChatMemberRequest) (*common.GroupChatMemberAddResponse, error) {
+		for _, id := range req.IDList {
+			chatMembers[id] = true
+		}
+		return &common.GroupChatMemberAddResponse{}, nil
+	}).Build()
+	Mock(common.GroupChatMemberDeleteAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string, req common.GroupChatMemberRequest) (*common.GroupChatMemberRemoveResponse, error) {
+		for _, id := range req.IDList {
+			delete(chatMembers, id)
+		}
+		return &common.GroupChatMemberRemoveResponse{}, nil
+	}).Build()
+	administrators := map[string]bool{}
+	Mock(common.GroupChatAdministratorAddAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string, req common.GroupChatAdministratorRequest) (*common.GroupChatAdministratorResponse, error) {
+		for _, id := range req.ManagerIDs {
+			administrators[id] = true
+		}
+		return &common.GroupChatAdministratorResponse{}, nil
+	}).Build()
+	Mock(common.GroupChatAdministratorDeleteAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string, req common.GroupChatAdministratorRequest) (*common.GroupChatAdministratorResponse, error) {
+		for _, id := range req.ManagerIDs {
+			delete(administrators, id)
+		}
+		return &common.GroupChatAdministratorResponse{}, nil
+	}).Build()
+	Mock(common.GroupChatMemberGetAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string) (*common.GroupChatMemberGetResponse, error) {
+		response := &common.GroupChatMemberGetResponse{}
+		for id := range chatMembers {
+			response.Data.Items = append(response.Data.Items, common.ListMember{MemberID: id})
+		}
+		return response, nil
+	}).Build()
+	defer UnPatchAll()
+
+	expectChatMembers := func(expected ...string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			if len(chatMembers) != len(expected) {
+				return fmt.Errorf("expected members %v, got %v", expected, chatMembers)
+			}
+			for _, id := range expected {
+				if !chatMembers[id] {
+					return fmt.Errorf("expected members %v, got %v", expected, chatMembers)
+				}
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			return expectChatMembers()(s)
+		},
+		Steps: []resource.TestStep{
+			// Create and Read Testing
+			{
+				Config: providerConfig + `resource "lark_group_chat_member" "test" {
+					group_chat_id         = "gc_test"
+					member_ids            = ["ou_0"]
+					source_department_ids = ["od-parent"]
+					source_recursive      = true
+					source_employee_types = [1]
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_group_chat_member.test", "effective_member_ids.#", "3"),
+					resource.TestCheckTypeSetElemAttr("lark_group_chat_member.test", "effective_member_ids.*", "ou_0"),
+					resource.TestCheckTypeSetElemAttr("lark_group_chat_member.test", "effective_member_ids.*", "ou_parent"),
+					resource.TestCheckTypeSetElemAttr("lark_group_chat_member.test", "effective_member_ids.*", "ou_child"),
+					expectChatMembers("ou_0", "ou_parent", "ou_child"),
+				),
+			},
+			// Department changes are picked up by the next plan
+			{
+				PreConfig: func() {
+					departmentUsers["od-child"] = departmentUsers["od-child"][1:]
+					departmentUsers["od-parent"] = append(departmentUsers["od-parent"], common.User{OpenID: "ou_new", EmployeeType: 1})
+				},
+				Config: providerConfig + `resource "lark_group_chat_member" "test" {
+					group_chat_id         = "gc_test"
+					member_ids            = ["ou_0"]
+					source_department_ids = ["od-parent"]
+					source_recursive      = true
+					source_employee_types = [1]
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_group_chat_member.test", "effective_member_ids.#", "3"),
+					resource.TestCheckTypeSetElemAttr("lark_group_chat_member.test", "effective_member_ids.*", "ou_new"),
+					expectChatMembers("ou_0", "ou_parent", "ou_new"),
+				),
+			},
+			// Administrators must be members
+			{
+				Config: providerConfig + `resource "lark_group_chat_member" "test" {
+					group_chat_id         = "gc_test"
+					member_ids            = ["ou_0"]
+					administrator_ids     = ["ou_intern"]
+					source_department_ids = ["od-parent"]
+					source_recursive      = true
+					source_employee_types = [1]
+				}
+				`,
+				ExpectError: regexp.MustCompile("Administrators Are Not Members"),
+			},
+			// Administrators can be members through a department
+			{
+				Config: providerConfig + `resource "lark_group_chat_member" "test" {
+					group_chat_id         = "gc_test"
+					member_ids            = ["ou_0"]
+					administrator_ids     = ["ou_parent"]
+					source_department_ids = ["od-parent"]
+					source_recursive      = true
+					source_employee_types = [1]
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_group_chat_member.test", "administrator_ids.0", "ou_parent"),
+					func(s *terraform.State) error {
+						if !administrators["ou_parent"] {
+							return fmt.Errorf("expected ou_parent to be an administrator, got %v", administrators)
+						}
+						return nil
+					},
+				),
+			},
+			// Departments known only after apply
+			{
+				Config: providerConfig + `resource "lark_group_chat_member" "test" {
+					group_chat_id         = "gc_test"
+					member_ids            = ["ou_0"]
+					source_department_ids = timestamp() != "" ? ["od-parent"] : []
+					source_recursive      = true
+					source_employee_types = [1]
+				}
+				`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue("lark_group_chat_member.test", tfjsonpath.New("effective_member_ids")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_group_chat_member.test", "effective_member_ids.#", "3"),
+					expectChatMembers("ou_0", "ou_parent", "ou_new"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			// Non recursive Testing
+			{
+				Config: providerConfig + `resource "lark_group_chat_member" "test" {
+					group_chat_id         = "gc_test"
+					source_department_ids = ["od-parent"]
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_group_chat_member.test", "effective_member_ids.#", "2"),
+					expectChatMembers("ou_parent", "ou_new"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
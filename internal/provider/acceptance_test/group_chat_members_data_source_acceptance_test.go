@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGroupChatMembersDataSource(t *testing.T) {
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.GroupChatGetWithUserIDTypeAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string, userIDType common.UserIDType) (*common.GroupChatGetResponse, error) {
+		if userIDType != common.UNION_ID {
+			return nil, fmt.Errorf("unexpected user_id_type %s", userIDType)
+		}
+		response := &common.GroupChatGetResponse{}
+		response.Data.OwnerID = "on_owner"
+		response.Data.OwnerIDType = "union_id"
+		response.Data.UserManagerIDList = []string{"on_admin"}
+		response.Data.BotManagerIDList = []string{"cli_bot"}
+		return response, nil
+	}).Build()
+	Mock(common.GroupChatMemberListAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string, memberIDType common.UserIDType) (*common.GroupChatMemberGetResponse, error) {
+		response := &common.GroupChatMemberGetResponse{}
+		response.Data.Items = []common.ListMember{
+			{MemberID: "on_owner", MemberIDType: "union_id", Name: "Owner"},
+			{MemberID: "on_admin", MemberIDType: "union_id", Name: "Admin"},
+			{MemberID: "on_member", MemberIDType: "union_id", Name: "Member"},
+		}
+		return response, nil
+	}).Build()
+	defer UnPatchAll()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `data "lark_group_chat_members" "test" {
+					group_chat_id  = "oc_test"
+					member_id_type = "union_id"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lark_group_chat_members.test", "members.#", "4"),
+					resource.TestCheckResourceAttr("data.lark_group_chat_members.test", "members.0.member_id", "on_owner"),
+					resource.TestCheckResourceAttr("data.lark_group_chat_members.test", "members.0.is_owner", "true"),
+					resource.TestCheckResourceAttr("data.lark_group_chat_members.test", "members.0.is_admin", "false"),
+					resource.TestCheckResourceAttr("data.lark_group_chat_members.test", "members.1.is_admin", "true"),
+					resource.TestCheckResourceAttr("data.lark_group_chat_members.test", "members.2.name", "Member"),
+					resource.TestCheckResourceAttr("data.lark_group_chat_members.test", "members.2.member_type", "user"),
+					resource.TestCheckResourceAttr("data.lark_group_chat_members.test", "members.2.is_admin", "false"),
+					resource.TestCheckResourceAttr("data.lark_group_chat_members.test", "members.3.member_id", "cli_bot"),
+					resource.TestCheckResourceAttr("data.lark_group_chat_members.test", "members.3.member_type", "bot"),
+					resource.TestCheckResourceAttr("data.lark_group_chat_members.test", "members.3.is_admin", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccGroupChatMenuResource(t *testing.T) {
+	nextMenuID := 1
+	topLevels := []common.GroupChatMenuTopLevel{}
+
+	treeResponse := func() *common.GroupChatMenuTreeResponse {
+		response := &common.GroupChatMenuTreeResponse{}
+		response.Data.MenuTree.ChatMenuTopLevels = append(response.Data.MenuTree.ChatMenuTopLevels, topLevels...)
+		return response
+	}
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.GroupChatMenuTreeGetAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string) (*common.GroupChatMenuTreeResponse, error) {
+		return treeResponse(), nil
+	}).Build()
+	Mock(common.GroupChatMenuTreeCreateAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string, req common.GroupChatMenuTreeRequest) (*common.GroupChatMenuTreeResponse, error) {
+		if len(topLevels)+len(req.MenuTree.ChatMenuTopLevels) > 3 {
+			return nil, fmt.Errorf("too many top-level menu items")
+		}
+		for _, topLevel := range req.MenuTree.ChatMenuTopLevels {
+			topLevel.ChatMenuTopLevelID = strconv.Itoa(nextMenuID)
+			nextMenuID++
+			for i := range topLevel.Children {
+				topLevel.Children[i].ChatMenuSecondLevelID = strconv.Itoa(nextMenuID)
+				nextMenuID++
+			}
+			topLevels = append(topLevels, topLevel)
+		}
+		return treeResponse(), nil
+	}).Build()
+	Mock(common.GroupChatMenuTreeDeleteAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string, req common.GroupChatMenuTopLevelIDsRequest) (*common.GroupChatMenuTreeResponse, error) {
+		topLevels = slices.DeleteFunc(topLevels, func(topLevel common.GroupChatMenuTopLevel) bool {
+			return slices.Contains(req.ChatMenuTopLevelIDs, topLevel.ChatMenuTopLevelID)
+		})
+		return treeResponse(), nil
+	}).Build()
+	Mock(common.GroupChatMenuTreeSortAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string, req common.GroupChatMenuTopLevelIDsRequest) (*common.GroupChatMenuTreeResponse, error) {
+		slices.SortFunc(topLevels, func(a, b common.GroupChatMenuTopLevel) int {
+			return slices.Index(req.ChatMenuTopLevelIDs, a.ChatMenuTopLevelID) - slices.Index(req.ChatMenuTopLevelIDs, b.ChatMenuTopLevelID)
+		})
+		return treeResponse(), nil
+	}).Build()
+	defer UnPatchAll()
+
+	expectServerMenu := func(names ...string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			got := []string{}
+			for _, topLevel := range topLevels {
+				got = append(got, topLevel.ChatMenuItem.Name)
+			}
+			if !slices.Equal(got, names) {
+				return fmt.Errorf("expected menu %v, got %v", names, got)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if len(topLevels) != 0 {
+				return fmt.Errorf("expected the menu to be empty, got %v", topLevels)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Validation Testing
+			{
+				Config: providerConfig + `
+				resource "lark_group_chat_menu" "example" {
+					group_chat_id = "oc_test"
+					items = [
+						{
+							name         = "Tools"
+							redirect_url = "https://example.com"
+							children = [
+								{
+									name         = "Dashboard"
+									redirect_url = "https://grafana.example.com"
+								},
+							]
+						},
+					]
+				}
+				`,
+				ExpectError: regexp.MustCompile("Redirect URL Not Allowed"),
+			},
+			{
+				Config: providerConfig + `
+				resource "lark_group_chat_menu" "example" {
+					group_chat_id = "oc_test"
+					items = [
+						{
+							name = "Tools"
+							children = [
+								{
+									name        = "Dashboard"
+									action_type = "NONE"
+									web_url     = "https://grafana.example.com"
+								},
+							]
+						},
+					]
+				}
+				`,
+				ExpectError: regexp.MustCompile("Redirect URL Not Allowed"),
+			},
+			// Create and Read Testing
+			{
+				Config: providerConfig + `
+				resource "lark_group_chat_menu" "example" {
+					group_chat_id = "oc_test"
+					items = [
+						{
+							name         = "Handbook"
+							redirect_url = "https://example.larksuite.com/wiki/handbook"
+						},
+						{
+							name = "Tools"
+							children = [
+								{
+									name         = "Dashboard"
+									redirect_url = "https://grafana.example.com"
+								},
+							]
+						},
+					]
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_group_chat_menu.example", "items.#", "2"),
+					resource.TestCheckResourceAttr("lark_group_chat_menu.example", "items.0.menu_item_id", "1"),
+					resource.TestCheckResourceAttr("lark_group_chat_menu.example", "items.1.menu_item_id", "2"),
+					resource.TestCheckResourceAttr("lark_group_chat_menu.example", "items.1.children.0.menu_item_id", "3"),
+					resource.TestCheckResourceAttr("lark_group_chat_menu.example", "items.0.action_type", "REDIRECT_LINK"),
+					resource.TestCheckResourceAttr("lark_group_chat_menu.example", "items.1.action_type", "NONE"),
+					resource.TestCheckResourceAttr("lark_group_chat_menu.example", "items.1.children.0.action_type", "REDIRECT_LINK"),
+					expectServerMenu("Handbook", "Tools"),
+				),
+			},
+			// Update, add and sort Testing
+			{
+				Config: providerConfig + `
+				resource "lark_group_chat_menu" "example" {
+					group_chat_id = "oc_test"
+					items = [
+						{
+							name = "Tools"
+							children = [
+								{
+									name         = "Dashboard"
+									redirect_url = "https://grafana.example.com"
+								},
+								{
+									name         = "On-call"
+									redirect_url = "https://example.larksuite.com/sheets/oncall"
+								},
+							]
+						},
+						{
+							name         = "Handbook"
+							redirect_url = "https://example.larksuite.com/wiki/handbook"
+						},
+						{
+							name         = "Status"
+							redirect_url = "https://status.example.com"
+							ios_url      = "https://status.example.com/mobile"
+						},
+					]
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_group_chat_menu.example", "items.#", "3"),
+					resource.TestCheckResourceAttr("lark_group_chat_menu.example", "items.0.menu_item_id", "4"),
+					resource.TestCheckResourceAttr("lark_group_chat_menu.example", "items.0.children.#", "2"),
+					resource.TestCheckResourceAttr("lark_group_chat_menu.example", "items.1.menu_item_id", "1"),
+					resource.TestCheckResourceAttr("lark_group_chat_menu.example", "items.2.menu_item_id", "7"),
+					resource.TestCheckResourceAttr("lark_group_chat_menu.example", "items.2.ios_url", "https://status.example.com/mobile"),
+					func(s *terraform.State) error {
+						if link := topLevels[2].ChatMenuItem.RedirectLink; link == nil || link.IosURL != "https://status.example.com/mobile" {
+							return fmt.Errorf("expected the iOS URL to be sent, got %+v", link)
+						}
+						return nil
+					},
+					expectServerMenu("Tools", "Handbook", "Status"),
+				),
+			},
+			// ImportState Testing
+			{
+				ResourceName:                         "lark_group_chat_menu.example",
+				ImportState:                          true,
+				ImportStateId:                        "oc_test",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "group_chat_id",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
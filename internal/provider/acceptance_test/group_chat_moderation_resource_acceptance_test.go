@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccGroupChatModerationResource(t *testing.T) {
+	// ou_old was made a moderator outside of terraform and must be removed.
+	moderationSetting := "moderator_list"
+	moderators := []string{"ou_old"}
+	var lastRequest common.GroupChatSpeechScopesUpdateRequest
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.GetUsersByIDAPI).Return(&common.UserInfoBatchGetResponse{
+		Data: struct {
+			Items []common.User `json:"items"`
+		}{
+			Items: []common.User{
+				{UserID: "0"},
+			},
+		},
+	}, nil).Build()
+	Mock(common.GroupChatModerationGetAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string) (*common.GroupChatModerationGetResponse, error) {
+		if chatID != "oc_test" {
+			return nil, fmt.Errorf("unexpected chat_id: %s", chatID)
+		}
+		response := &common.GroupChatModerationGetResponse{}
+		response.Data.ModerationSetting = moderationSetting
+		if moderationSetting == "moderator_list" {
+			for _, moderator := range moderators {
+				response.Data.Items = append(response.Data.Items, common.GroupChatModerator{UserIDType: "open_id", UserID: moderator})
+			}
+		}
+		return response, nil
+	}).Build()
+	Mock(common.GroupChatSpeechScopesUpdateAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string, req common.GroupChatSpeechScopesUpdateRequest) (*common.BaseResponse, error) {
+		lastRequest = req
+		moderationSetting = req.ModerationSetting
+		moderators = append(moderators, req.ModeratorAddedList...)
+		moderators = slices.DeleteFunc(moderators, func(moderator string) bool {
+			return slices.Contains(req.ModeratorRemovedList, moderator)
+		})
+		return &common.BaseResponse{}, nil
+	}).Build()
+	defer UnPatchAll()
+
+	expectLastRequest := func(added []string, removed []string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			if !slices.Equal(lastRequest.ModeratorAddedList, added) || !slices.Equal(lastRequest.ModeratorRemovedList, removed) {
+				return fmt.Errorf("expected added %v and removed %v, got added %v and removed %v", added, removed, lastRequest.ModeratorAddedList, lastRequest.ModeratorRemovedList)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if moderationSetting != "all_members" {
+				return fmt.Errorf("expected moderation to be reset to all_members, got %s", moderationSetting)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read Testing
+			{
+				Config: providerConfig + `
+				resource "lark_group_chat_moderation" "example" {
+					group_chat_id      = "oc_test"
+					moderation_setting = "moderator_list"
+					moderator_ids      = ["ou_a", "ou_b"]
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_group_chat_moderation.example", "moderation_setting", "moderator_list"),
+					resource.TestCheckResourceAttr("lark_group_chat_moderation.example", "moderator_ids.#", "2"),
+					expectLastRequest([]string{"ou_a", "ou_b"}, []string{"ou_old"}),
+				),
+			},
+			// Update and Read Testing
+			{
+				Config: providerConfig + `
+				resource "lark_group_chat_moderation" "example" {
+					group_chat_id      = "oc_test"
+					moderation_setting = "moderator_list"
+					moderator_ids      = ["ou_b", "ou_c"]
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_group_chat_moderation.example", "moderator_ids.#", "2"),
+					resource.TestCheckResourceAttr("lark_group_chat_moderation.example", "moderator_ids.0", "ou_b"),
+					resource.TestCheckResourceAttr("lark_group_chat_moderation.example", "moderator_ids.1", "ou_c"),
+					expectLastRequest([]string{"ou_c"}, []string{"ou_a"}),
+				),
+			},
+			// ImportState Testing
+			{
+				ResourceName:                         "lark_group_chat_moderation.example",
+				ImportState:                          true,
+				ImportStateId:                        "oc_test",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "group_chat_id",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+			// Only owner doesn't need moderators
+			{
+				Config: providerConfig + `
+				resource "lark_group_chat_moderation" "example" {
+					group_chat_id      = "oc_test"
+					moderation_setting = "only_owner"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_group_chat_moderation.example", "moderation_setting", "only_owner"),
+					resource.TestCheckNoResourceAttr("lark_group_chat_moderation.example", "moderator_ids"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/aganisatria/terraform-provider-lark/internal/common"
 	. "github.com/bytedance/mockey"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccGroupChatResource(t *testing.T) {
@@ -302,3 +303,166 @@ func TestAccGroupChatResource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccGroupChatResourceCreateWithRestrictedPermissions(t *testing.T) {
+	// Lark always creates a group chat with the default permissions, the configured ones are applied by an update afterwards.
+	createResponse := &common.GroupChatCreateResponse{}
+	createResponse.Data.ChatID = "test_chat_id"
+	createResponse.Data.Name = "ini contoh"
+	createResponse.Data.AddMemberPermission = "all_members"
+	createResponse.Data.ShareCardPermission = "allowed"
+	createResponse.Data.AtAllPermission = "all_members"
+
+	getResponse := &common.GroupChatGetResponse{}
+	getResponse.Data.Name = "ini contoh"
+	getResponse.Data.AddMemberPermission = "all_members"
+	getResponse.Data.ShareCardPermission = "allowed"
+	getResponse.Data.AtAllPermission = "all_members"
+
+	updateCalls := 0
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.GroupChatCreateAPI).Return(createResponse, nil).Build()
+	Mock(common.GroupChatGetAPI).Return(getResponse, nil).Build()
+	Mock(common.GroupChatUpdateAPI).To(func(ctx context.Context, client *common.LarkClient, groupID string, req common.GroupChatUpdateRequest) (*common.BaseResponse, error) {
+		if groupID != "test_chat_id" {
+			return nil, fmt.Errorf("unexpected group_id: %s", groupID)
+		}
+		updateCalls++
+		getResponse.Data.AddMemberPermission = req.AddMemberPermission
+		getResponse.Data.ShareCardPermission = req.ShareCardPermission
+		getResponse.Data.AtAllPermission = req.AtAllPermission
+		return &common.BaseResponse{
+			Code: 0,
+		}, nil
+	}).Build()
+	Mock(common.GroupChatDeleteAPI).Return(&common.BaseResponse{
+		Code: 0,
+	}, nil).Build()
+	defer UnPatchAll()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read Testing
+			{
+				Config: providerConfig + `
+				resource "lark_group_chat" "example" {
+				name                  = "ini contoh"
+				add_member_permission = "only_owner"
+				share_card_permission = "not_allowed"
+				at_all_permission     = "only_owner"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_group_chat.example", "chat_id", "test_chat_id"),
+					resource.TestCheckResourceAttr("lark_group_chat.example", "add_member_permission", "only_owner"),
+					resource.TestCheckResourceAttr("lark_group_chat.example", "share_card_permission", "not_allowed"),
+					resource.TestCheckResourceAttr("lark_group_chat.example", "at_all_permission", "only_owner"),
+					func(s *terraform.State) error {
+						if updateCalls != 1 {
+							return fmt.Errorf("expected permissions to be applied with 1 update call, got %d", updateCalls)
+						}
+						return nil
+					},
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGroupChatResourceOwnershipTransfer(t *testing.T) {
+	createResponse := &common.GroupChatCreateResponse{}
+	createResponse.Data.ChatID = "test_chat_id"
+	createResponse.Data.Name = "ini contoh"
+	createResponse.Data.AddMemberPermission = "all_members"
+	createResponse.Data.ShareCardPermission = "allowed"
+	createResponse.Data.AtAllPermission = "all_members"
+
+	getResponse := &common.GroupChatGetResponse{}
+	getResponse.Data.Name = "ini contoh"
+	getResponse.Data.AddMemberPermission = "all_members"
+	getResponse.Data.ShareCardPermission = "allowed"
+	getResponse.Data.AtAllPermission = "all_members"
+
+	leaveCalls := 0
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.GetUsersByIDAPI).Return(&common.UserInfoBatchGetResponse{
+		Data: struct {
+			Items []common.User `json:"items"`
+		}{
+			Items: []common.User{
+				{UserID: "0"},
+			},
+		},
+	}, nil).Build()
+	Mock(common.GroupChatCreateAPI).Return(createResponse, nil).Build()
+	Mock(common.GroupChatGetAPI).Return(getResponse, nil).Build()
+	Mock(common.GroupChatUpdateAPI).To(func(ctx context.Context, client *common.LarkClient, groupID string, req common.GroupChatUpdateRequest) (*common.BaseResponse, error) {
+		if groupID != "test_chat_id" {
+			return nil, fmt.Errorf("unexpected group_id: %s", groupID)
+		}
+		if req.OwnerID != "" {
+			getResponse.Data.OwnerID = req.OwnerID
+			getResponse.Data.OwnerIDType = "open_id"
+		}
+		return &common.BaseResponse{
+			Code: 0,
+		}, nil
+	}).Build()
+	Mock(common.GroupChatMemberDeleteAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string, req common.GroupChatMemberRequest) (*common.GroupChatMemberRemoveResponse, error) {
+		if len(req.IDList) != 1 || req.IDList[0] != client.AppID {
+			return nil, fmt.Errorf("expected the bot to leave, got %v", req.IDList)
+		}
+		leaveCalls++
+		return &common.GroupChatMemberRemoveResponse{}, nil
+	}).Build()
+	Mock(common.GroupChatDeleteAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string) (*common.BaseResponse, error) {
+		return nil, fmt.Errorf("group chat %s must not be dissolved after the ownership is transferred", chatID)
+	}).Build()
+	defer UnPatchAll()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if leaveCalls != 1 {
+				return fmt.Errorf("expected the bot to leave the group chat once, got %d", leaveCalls)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read Testing
+			{
+				Config: providerConfig + `
+				resource "lark_group_chat" "example" {
+				name                  = "ini contoh"
+				owner_id              = "ou_owner"
+				leave_chat_on_destroy = true
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_group_chat.example", "owner_id", "ou_owner"),
+					resource.TestCheckResourceAttr("lark_group_chat.example", "owner_id_type", "open_id"),
+					resource.TestCheckResourceAttr("lark_group_chat.example", "leave_chat_on_destroy", "true"),
+				),
+			},
+			// Update and Read Testing
+			{
+				Config: providerConfig + `
+				resource "lark_group_chat" "example" {
+				name                  = "ini contoh"
+				owner_id              = "ou_new_owner"
+				leave_chat_on_destroy = true
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_group_chat.example", "owner_id", "ou_new_owner"),
+					resource.TestCheckResourceAttr("lark_group_chat.example", "owner_id_type", "open_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
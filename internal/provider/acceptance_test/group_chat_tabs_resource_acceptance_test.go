@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccGroupChatTabsResource(t *testing.T) {
+	// The message tab is built in, and "Old" was added in the UI and must be deleted.
+	nextTabID := 3
+	tabs := []common.GroupChatTab{
+		{TabID: "1", TabName: "Message", TabType: "message"},
+		{TabID: "2", TabName: "Old", TabType: "url", TabContent: &common.GroupChatTabContent{URL: "https://old.example.com"}},
+	}
+
+	listResponse := func() *common.GroupChatTabsResponse {
+		response := &common.GroupChatTabsResponse{}
+		response.Data.ChatTabs = append(response.Data.ChatTabs, tabs...)
+		return response
+	}
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.GroupChatTabListAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string) (*common.GroupChatTabsResponse, error) {
+		return listResponse(), nil
+	}).Build()
+	Mock(common.GroupChatTabCreateAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string, req common.GroupChatTabsRequest) (*common.GroupChatTabsResponse, error) {
+		for _, tab := range req.ChatTabs {
+			tab.TabID = strconv.Itoa(nextTabID)
+			nextTabID++
+			tabs = append(tabs, tab)
+		}
+		return listResponse(), nil
+	}).Build()
+	Mock(common.GroupChatTabUpdateAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string, req common.GroupChatTabsRequest) (*common.GroupChatTabsResponse, error) {
+		for _, updated := range req.ChatTabs {
+			for i, tab := range tabs {
+				if tab.TabID == updated.TabID {
+					tabs[i] = updated
+				}
+			}
+		}
+		return listResponse(), nil
+	}).Build()
+	Mock(common.GroupChatTabDeleteAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string, req common.GroupChatTabIDsRequest) (*common.GroupChatTabsResponse, error) {
+		tabs = slices.DeleteFunc(tabs, func(tab common.GroupChatTab) bool {
+			return slices.Contains(req.TabIDs, tab.TabID)
+		})
+		return listResponse(), nil
+	}).Build()
+	Mock(common.GroupChatTabSortAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string, req common.GroupChatTabIDsRequest) (*common.GroupChatTabsResponse, error) {
+		if len(req.TabIDs) == 0 || req.TabIDs[0] != "1" {
+			return nil, fmt.Errorf("expected the message tab to stay first, got %v", req.TabIDs)
+		}
+		slices.SortFunc(tabs, func(a, b common.GroupChatTab) int {
+			return slices.Index(req.TabIDs, a.TabID) - slices.Index(req.TabIDs, b.TabID)
+		})
+		return listResponse(), nil
+	}).Build()
+	defer UnPatchAll()
+
+	expectServerTabs := func(names ...string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			got := []string{}
+			for _, tab := range tabs {
+				got = append(got, tab.TabName)
+			}
+			if !slices.Equal(got, names) {
+				return fmt.Errorf("expected tabs %v, got %v", names, got)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if len(tabs) != 1 || tabs[0].TabType != "message" {
+				return fmt.Errorf("expected only the message tab to be left, got %v", tabs)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read Testing
+			{
+				Config: providerConfig + `
+				resource "lark_group_chat_tabs" "example" {
+					group_chat_id = "oc_test"
+					tabs = [
+						{
+							name = "Runbook"
+							type = "doc"
+							url  = "https://example.larksuite.com/docx/runbook"
+						},
+						{
+							name = "Dashboard"
+							type = "url"
+							url  = "https://grafana.example.com"
+						},
+					]
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_group_chat_tabs.example", "tabs.#", "2"),
+					resource.TestCheckResourceAttr("lark_group_chat_tabs.example", "tabs.0.tab_id", "3"),
+					resource.TestCheckResourceAttr("lark_group_chat_tabs.example", "tabs.1.tab_id", "4"),
+					expectServerTabs("Message", "Runbook", "Dashboard"),
+				),
+			},
+			// Update, add and sort Testing
+			{
+				Config: providerConfig + `
+				resource "lark_group_chat_tabs" "example" {
+					group_chat_id = "oc_test"
+					tabs = [
+						{
+							name = "On-call"
+							type = "doc"
+							url  = "https://example.larksuite.com/sheets/oncall"
+						},
+						{
+							name = "Dashboard"
+							type = "url"
+							url  = "https://grafana.example.com/d/team"
+						},
+						{
+							name = "Runbook"
+							type = "doc"
+							url  = "https://example.larksuite.com/docx/runbook"
+						},
+					]
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_group_chat_tabs.example", "tabs.#", "3"),
+					resource.TestCheckResourceAttr("lark_group_chat_tabs.example", "tabs.0.tab_id", "5"),
+					resource.TestCheckResourceAttr("lark_group_chat_tabs.example", "tabs.1.tab_id", "4"),
+					resource.TestCheckResourceAttr("lark_group_chat_tabs.example", "tabs.1.url", "https://grafana.example.com/d/team"),
+					resource.TestCheckResourceAttr("lark_group_chat_tabs.example", "tabs.2.tab_id", "3"),
+					expectServerTabs("Message", "On-call", "Dashboard", "Runbook"),
+				),
+			},
+			// ImportState Testing
+			{
+				ResourceName:                         "lark_group_chat_tabs.example",
+				ImportState:                          true,
+				ImportStateId:                        "oc_test",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "group_chat_id",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccGroupChatTopNoticeResource(t *testing.T) {
+	var topNotice *common.GroupChatTopNotice
+	recalledMessages := []string{}
+	var messageGetErr error
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.GroupChatGetAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string) (*common.GroupChatGetResponse, error) {
+		response := &common.GroupChatGetResponse{}
+		response.Data.ChatStatus = "normal"
+		return response, nil
+	}).Build()
+	Mock(common.MessageGetAPI).To(func(ctx context.Context, client *common.LarkClient, messageID string) (*common.MessageGetResponse, error) {
+		if messageGetErr != nil {
+			return nil, messageGetErr
+		}
+		response := &common.MessageGetResponse{}
+		response.Data.Items = []common.Message{
+			{MessageID: messageID, ChatID: "oc_test", Deleted: slices.Contains(recalledMessages, messageID)},
+		}
+		return response, nil
+	}).Build()
+	Mock(common.GroupChatTopNoticePutAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string, req common.GroupChatTopNoticeRequest) (*common.BaseResponse, error) {
+		topNotice = &req.ChatTopNotice[0]
+		return &common.BaseResponse{}, nil
+	}).Build()
+	Mock(common.GroupChatTopNoticeDeleteAPI).To(func(ctx context.Context, client *common.LarkClient, chatID string) (*common.BaseResponse, error) {
+		topNotice = nil
+		return &common.BaseResponse{}, nil
+	}).Build()
+	defer UnPatchAll()
+
+	expectServerTopNotice := func(actionType string, messageID string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			if topNotice == nil || topNotice.ActionType != actionType || topNotice.MessageID != messageID {
+				return fmt.Errorf("expected top notice %s %s, got %v", actionType, messageID, topNotice)
+			}
+			return nil
+		}
+	}
+
+	messageConfig := providerConfig + `
+	resource "lark_group_chat_top_notice" "example" {
+		group_chat_id = "oc_test"
+		notice_type   = "message"
+		message_id    = "om_test"
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if topNotice != nil {
+				return fmt.Errorf("expected the top notice to be removed, got %v", topNotice)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Validation Testing
+			{
+				Config: providerConfig + `
+				resource "lark_group_chat_top_notice" "example" {
+					group_chat_id = "oc_test"
+					notice_type   = "message"
+				}
+				`,
+				ExpectError: regexp.MustCompile("Message ID Required"),
+			},
+			// Create and Read Testing
+			{
+				Config: messageConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_group_chat_top_notice.example", "notice_type", "message"),
+					resource.TestCheckResourceAttr("lark_group_chat_top_notice.example", "message_id", "om_test"),
+					expectServerTopNotice("1", "om_test"),
+				),
+			},
+			// Recalled message Testing
+			{
+				PreConfig: func() {
+					recalledMessages = append(recalledMessages, "om_test")
+				},
+				Config:             messageConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Failed Read Testing, the top notice must not be pinned again.
+			{
+				PreConfig: func() {
+					recalledMessages = nil
+					messageGetErr = fmt.Errorf("API error when getting message: %w", &common.APIError{Code: 99991400, Msg: "request trigger frequency limit"})
+				},
+				Config:      messageConfig,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("API Error Reading Message"),
+			},
+			// Update Testing
+			{
+				PreConfig: func() {
+					messageGetErr = nil
+				},
+				Config: providerConfig + `
+				resource "lark_group_chat_top_notice" "example" {
+					group_chat_id = "oc_test"
+					notice_type   = "announcement"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_group_chat_top_notice.example", "notice_type", "announcement"),
+					resource.TestCheckNoResourceAttr("lark_group_chat_top_notice.example", "message_id"),
+					expectServerTopNotice("2", ""),
+				),
+			},
+			// ImportState Testing
+			{
+				ResourceName:                         "lark_group_chat_top_notice.example",
+				ImportState:                          true,
+				ImportStateId:                        "oc_test",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "group_chat_id",
+				ImportStateVerifyIgnore:              []string{"last_updated", "notice_type"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGroupChatsDataSource(t *testing.T) {
+	response := &common.GroupChatListResponse{}
+	response.Data.Items = []common.GroupChatListItem{
+		{ChatID: "oc_oncall", Name: "Team On-call", OwnerID: "ou_owner", OwnerIDType: "open_id", ChatStatus: "normal"},
+		{ChatID: "oc_ops", Name: "Ops", OwnerID: "ou_owner", OwnerIDType: "open_id", ChatStatus: "normal"},
+		{ChatID: "oc_partner", Name: "Partner On-call", External: true, ChatStatus: "normal"},
+	}
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.GroupChatListAPI).Return(response, nil).Build()
+	defer UnPatchAll()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `data "lark_group_chats" "all" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lark_group_chats.all", "chats.#", "3"),
+					resource.TestCheckResourceAttr("data.lark_group_chats.all", "chats.1.chat_id", "oc_ops"),
+					resource.TestCheckResourceAttr("data.lark_group_chats.all", "chats.1.owner_id", "ou_owner"),
+				),
+			},
+			{
+				Config: providerConfig + `data "lark_group_chats" "oncall" {
+					name_contains = "On-call"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lark_group_chats.oncall", "chats.#", "2"),
+					resource.TestCheckResourceAttr("data.lark_group_chats.oncall", "chats.0.chat_id", "oc_oncall"),
+					resource.TestCheckResourceAttr("data.lark_group_chats.oncall", "chats.1.chat_id", "oc_partner"),
+					resource.TestCheckResourceAttr("data.lark_group_chats.oncall", "chats.1.external", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccImImageResource(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "avatar.png")
+	movedSource := filepath.Join(dir, "moved_avatar.png")
+
+	uploadCalls := 0
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.ImageUploadAPI).To(func(ctx context.Context, client *common.LarkClient, imageType string, fileName string, image []byte) (*common.ImageUploadResponse, error) {
+		if imageType != "avatar" {
+			return nil, fmt.Errorf("unexpected image_type: %s", imageType)
+		}
+		uploadCalls++
+		response := &common.ImageUploadResponse{}
+		response.Data.ImageKey = fmt.Sprintf("img_v2_%d", uploadCalls)
+		return response, nil
+	}).Build()
+	defer UnPatchAll()
+
+	expectUploadCalls := func(want int) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			if uploadCalls != want {
+				return fmt.Errorf("expected %d uploads, got %d", want, uploadCalls)
+			}
+			return nil
+		}
+	}
+
+	config := func(path string) string {
+		return providerConfig + fmt.Sprintf(`
+		resource "lark_im_image" "example" {
+			source     = %q
+			image_type = "avatar"
+		}
+		`, path)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read Testing
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(source, []byte("first image"), 0o600); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config(source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_im_image.example", "image_key", "img_v2_1"),
+					resource.TestCheckResourceAttr("lark_im_image.example", "content_hash", "fb4516b50946085bd15d6807d1526c967afdfae509a6088b7817853bac9bed0c"),
+					expectUploadCalls(1),
+				),
+			},
+			// Changed content uploads a new image
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(source, []byte("second image"), 0o600); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config(source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_im_image.example", "image_key", "img_v2_2"),
+					expectUploadCalls(2),
+				),
+			},
+			// Moving the file without changing its content keeps the image
+			{
+				PreConfig: func() {
+					if err := os.Rename(source, movedSource); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config(movedSource),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_im_image.example", "image_key", "img_v2_2"),
+					resource.TestCheckResourceAttr("lark_im_image.example", "source", movedSource),
+					expectUploadCalls(2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
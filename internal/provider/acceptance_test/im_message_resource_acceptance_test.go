@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccImMessageResource(t *testing.T) {
+	messages := map[string]*common.Message{}
+	pinned := map[string]bool{}
+	sentUUIDs := []string{}
+	var getErr error
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.MessageSendAPI).To(func(ctx context.Context, client *common.LarkClient, receiveIDType string, req common.MessageSendRequest) (*common.MessageResponse, error) {
+		if receiveIDType != "chat_id" {
+			return nil, fmt.Errorf("unexpected receive_id_type %s", receiveIDType)
+		}
+		sentUUIDs = append(sentUUIDs, req.UUID)
+		message := &common.Message{
+			MessageID: fmt.Sprintf("om_%d", len(sentUUIDs)),
+			MsgType:   req.MsgType,
+			ChatID:    req.ReceiveID,
+			Body:      common.MessageBody{Content: req.Content},
+		}
+		messages[message.MessageID] = message
+		return &common.MessageResponse{Data: *message}, nil
+	}).Build()
+	Mock(common.MessageGetAPI).To(func(ctx context.Context, client *common.LarkClient, messageID string) (*common.MessageGetResponse, error) {
+		if getErr != nil {
+			return nil, getErr
+		}
+		response := &common.MessageGetResponse{}
+		if message, ok := messages[messageID]; ok {
+			response.Data.Items = []common.Message{*message}
+		}
+		return response, nil
+	}).Build()
+	Mock(common.MessageUpdateAPI).To(func(ctx context.Context, client *common.LarkClient, messageID string, req common.MessageUpdateRequest) (*common.BaseResponse, error) {
+		messages[messageID].Body.Content = req.Content
+		return &common.BaseResponse{}, nil
+	}).Build()
+	Mock(common.MessageCardPatchAPI).To(func(ctx context.Context, client *common.LarkClient, messageID string, req common.MessageUpdateRequest) (*common.BaseResponse, error) {
+		return nil, fmt.Errorf("text messages must not be patched as cards")
+	}).Build()
+	Mock(common.MessagePinAPI).To(func(ctx context.Context, client *common.LarkClient, messageID string) (*common.BaseResponse, error) {
+		pinned[messageID] = true
+		return &common.BaseResponse{}, nil
+	}).Build()
+	Mock(common.MessageUnpinAPI).To(func(ctx context.Context, client *common.LarkClient, messageID string) (*common.BaseResponse, error) {
+		delete(pinned, messageID)
+		return &common.BaseResponse{}, nil
+	}).Build()
+	Mock(common.MessageRecallAPI).To(func(ctx context.Context, client *common.LarkClient, messageID string) (*common.BaseResponse, error) {
+		delete(messages, messageID)
+		delete(pinned, messageID)
+		return &common.BaseResponse{}, nil
+	}).Build()
+	defer UnPatchAll()
+
+	expectServerMessage := func(messageID string, content string, isPinned bool) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			message, ok := messages[messageID]
+			if !ok {
+				return fmt.Errorf("message %s not found", messageID)
+			}
+			if message.Body.Content != content {
+				return fmt.Errorf("expected content %s, got %s", content, message.Body.Content)
+			}
+			if pinned[messageID] != isPinned {
+				return fmt.Errorf("expected pinned %t, got %t", isPinned, pinned[messageID])
+			}
+			if len(sentUUIDs) != 1 || sentUUIDs[0] == "" {
+				return fmt.Errorf("expected the message to be sent once with a uuid, got %v", sentUUIDs)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if len(messages) != 0 {
+				return fmt.Errorf("expected every message to be recalled, got %v", messages)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Validation Testing
+			{
+				Config: providerConfig + `
+				resource "lark_im_message" "welcome" {
+					receive_id = "oc_test"
+					msg_type   = "text"
+					content    = "Welcome"
+				}
+				`,
+				ExpectError: regexp.MustCompile("Invalid Message Content"),
+			},
+			// Create and Read Testing
+			{
+				Config: providerConfig + `
+				resource "lark_im_message" "welcome" {
+					receive_id = "oc_test"
+					msg_type   = "text"
+					content    = jsonencode({ text = "Welcome" })
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_im_message.welcome", "message_id", "om_1"),
+					resource.TestCheckResourceAttr("lark_im_message.welcome", "chat_id", "oc_test"),
+					resource.TestCheckResourceAttr("lark_im_message.welcome", "receive_id_type", "chat_id"),
+					resource.TestCheckResourceAttr("lark_im_message.welcome", "pinned", "false"),
+					resource.TestMatchResourceAttr("lark_im_message.welcome", "uuid", regexp.MustCompile(`^[0-9a-f-]{36}$`)),
+					expectServerMessage("om_1", `{"text":"Welcome"}`, false),
+				),
+			},
+			// Update in place and pin Testing
+			{
+				Config: providerConfig + `
+				resource "lark_im_message" "welcome" {
+					receive_id = "oc_test"
+					msg_type   = "text"
+					content    = jsonencode({ text = "Welcome to the team" })
+					pinned     = true
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_im_message.welcome", "message_id", "om_1"),
+					resource.TestCheckResourceAttr("lark_im_message.welcome", "pinned", "true"),
+					expectServerMessage("om_1", `{"text":"Welcome to the team"}`, true),
+				),
+			},
+			// Failed Read Testing, the message must be kept in the state and not sent again.
+			{
+				PreConfig: func() {
+					getErr = fmt.Errorf("API error when getting message: %w", &common.APIError{Code: 99991400, Msg: "request trigger frequency limit"})
+				},
+				Config: providerConfig + `
+				resource "lark_im_message" "welcome" {
+					receive_id = "oc_test"
+					msg_type   = "text"
+					content    = jsonencode({ text = "Welcome to the team" })
+					pinned     = true
+				}
+				`,
+				ExpectError: regexp.MustCompile("API Error Reading Message"),
+			},
+			{
+				PreConfig: func() {
+					getErr = nil
+				},
+				Config: providerConfig + `
+				resource "lark_im_message" "welcome" {
+					receive_id = "oc_test"
+					msg_type   = "text"
+					content    = jsonencode({ text = "Welcome to the team" })
+					pinned     = true
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_im_message.welcome", "message_id", "om_1"),
+					expectServerMessage("om_1", `{"text":"Welcome to the team"}`, true),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccJobFamilyResource(t *testing.T) {
+	jobFamilies := map[string]common.JobFamily{}
+	created := 0
+	var getErr error
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.JobFamilyCreateAPI).To(func(ctx context.Context, client *common.LarkClient, request common.JobFamilyRequest) (*common.JobFamilyResponse, error) {
+		if _, ok := jobFamilies[request.ParentJobFamilyID]; request.ParentJobFamilyID != "" && !ok {
+			return nil, fmt.Errorf("API error when creating job family: parent job family not found")
+		}
+		created++
+		jobFamilyID := fmt.Sprintf("family_%d", created)
+		jobFamilies[jobFamilyID] = common.JobFamily{JobFamilyRequest: request, JobFamilyID: jobFamilyID}
+		response := &common.JobFamilyResponse{}
+		response.Data.JobFamily = jobFamilies[jobFamilyID]
+		return response, nil
+	}).Build()
+	Mock(common.JobFamilyGetAPI).To(func(ctx context.Context, client *common.LarkClient, jobFamilyID string) (*common.JobFamilyResponse, error) {
+		if getErr != nil {
+			return nil, getErr
+		}
+		jobFamily, ok := jobFamilies[jobFamilyID]
+		if !ok {
+			return nil, fmt.Errorf("API error when getting job family: %w", &common.APIError{Code: common.JOB_FAMILY_NOT_FOUND_CODE, Msg: "job family not found"})
+		}
+		response := &common.JobFamilyResponse{}
+		response.Data.JobFamily = jobFamily
+		return response, nil
+	}).Build()
+	Mock(common.JobFamilyUpdateAPI).To(func(ctx context.Context, client *common.LarkClient, jobFamilyID string, request common.JobFamilyRequest) (*common.JobFamilyResponse, error) {
+		if _, ok := jobFamilies[jobFamilyID]; !ok {
+			return nil, fmt.Errorf("API error when updating job family: job family not found")
+		}
+		jobFamilies[jobFamilyID] = common.JobFamily{JobFamilyRequest: request, JobFamilyID: jobFamilyID}
+		response := &common.JobFamilyResponse{}
+		response.Data.JobFamily = jobFamilies[jobFamilyID]
+		return response, nil
+	}).Build()
+	Mock(common.JobFamilyDeleteAPI).To(func(ctx context.Context, client *common.LarkClient, jobFamilyID string) (*common.BaseResponse, error) {
+		delete(jobFamilies, jobFamilyID)
+		return &common.BaseResponse{}, nil
+	}).Build()
+	defer UnPatchAll()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if len(jobFamilies) != 0 {
+				return fmt.Errorf("%d job families still exist", len(jobFamilies))
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read Testing
+			{
+				Config: providerConfig + `resource "lark_job_family" "parent" {
+					name = "Engineering"
+				}
+
+				resource "lark_job_family" "test" {
+					name                 = "Backend"
+					parent_job_family_id = lark_job_family.parent.job_family_id
+					i18n_description = [
+						{
+							locale = "en_us"
+							value  = "Server side engineering"
+						}
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_job_family.parent", "job_family_id", "family_1"),
+					resource.TestCheckNoResourceAttr("lark_job_family.parent", "parent_job_family_id"),
+					resource.TestCheckResourceAttr("lark_job_family.test", "job_family_id", "family_2"),
+					resource.TestCheckResourceAttr("lark_job_family.test", "parent_job_family_id", "family_1"),
+					resource.TestCheckResourceAttr("lark_job_family.test", "i18n_description.0.value", "Server side engineering"),
+				),
+			},
+
+			// Import Testing
+			{
+				ResourceName:                         "lark_job_family.test",
+				ImportState:                          true,
+				ImportStateId:                        "family_2",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "job_family_id",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+
+			// Update and Read Testing
+			{
+				Config: providerConfig + `resource "lark_job_family" "parent" {
+					name = "Engineering"
+				}
+
+				resource "lark_job_family" "test" {
+					name                 = "Backend Engineering"
+					parent_job_family_id = lark_job_family.parent.job_family_id
+					status               = false
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_job_family.test", "job_family_id", "family_2"),
+					resource.TestCheckResourceAttr("lark_job_family.test", "name", "Backend Engineering"),
+					resource.TestCheckResourceAttr("lark_job_family.test", "status", "false"),
+					resource.TestCheckNoResourceAttr("lark_job_family.test", "i18n_description"),
+					func(s *terraform.State) error {
+						if len(jobFamilies["family_2"].I18nDescription) != 0 {
+							return fmt.Errorf("i18n description of job family family_2 was not cleared")
+						}
+						return nil
+					},
+				),
+			},
+
+			// Move back to the top level Testing
+			{
+				Config: providerConfig + `resource "lark_job_family" "parent" {
+					name = "Engineering"
+				}
+
+				resource "lark_job_family" "test" {
+					name   = "Backend Engineering"
+					status = false
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_job_family.test", "job_family_id", "family_2"),
+					resource.TestCheckNoResourceAttr("lark_job_family.test", "parent_job_family_id"),
+					func(s *terraform.State) error {
+						if parent := jobFamilies["family_2"].ParentJobFamilyID; parent != "" {
+							return fmt.Errorf("job family family_2 still has parent %s", parent)
+						}
+						return nil
+					},
+				),
+			},
+
+			// A failed read keeps the job family in the state
+			{
+				PreConfig: func() {
+					getErr = &common.APIError{Code: 99991400, Msg: "request trigger frequency limit"}
+				},
+				Config: providerConfig + `resource "lark_job_family" "parent" {
+					name = "Engineering"
+				}
+
+				resource "lark_job_family" "test" {
+					name   = "Backend Engineering"
+					status = false
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("API Error Reading Job Family"),
+			},
+
+			// Recovery Testing
+			{
+				PreConfig: func() {
+					getErr = nil
+				},
+				Config: providerConfig + `resource "lark_job_family" "parent" {
+					name = "Engineering"
+				}
+
+				resource "lark_job_family" "test" {
+					name   = "Backend Engineering"
+					status = false
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_job_family.test", "job_family_id", "family_2"),
+				),
+			},
+
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccJobLevelResource(t *testing.T) {
+	jobLevels := map[string]common.JobLevel{}
+	var getErr error
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.JobLevelCreateAPI).To(func(ctx context.Context, client *common.LarkClient, request common.JobLevelRequest) (*common.JobLevelResponse, error) {
+		jobLevelID := fmt.Sprintf("level_%d", len(jobLevels)+1)
+		jobLevels[jobLevelID] = common.JobLevel{JobLevelRequest: request, JobLevelID: jobLevelID}
+		response := &common.JobLevelResponse{}
+		response.Data.JobLevel = jobLevels[jobLevelID]
+		return response, nil
+	}).Build()
+	Mock(common.JobLevelGetAPI).To(func(ctx context.Context, client *common.LarkClient, jobLevelID string) (*common.JobLevelResponse, error) {
+		if getErr != nil {
+			return nil, getErr
+		}
+		jobLevel, ok := jobLevels[jobLevelID]
+		if !ok {
+			return nil, fmt.Errorf("API error when getting job level: %w", &common.APIError{Code: common.JOB_LEVEL_NOT_FOUND_CODE, Msg: "job level not found"})
+		}
+		response := &common.JobLevelResponse{}
+		response.Data.JobLevel = jobLevel
+		return response, nil
+	}).Build()
+	Mock(common.JobLevelUpdateAPI).To(func(ctx context.Context, client *common.LarkClient, jobLevelID string, request common.JobLevelRequest) (*common.JobLevelResponse, error) {
+		if _, ok := jobLevels[jobLevelID]; !ok {
+			return nil, fmt.Errorf("API error when updating job level: job level not found")
+		}
+		jobLevels[jobLevelID] = common.JobLevel{JobLevelRequest: request, JobLevelID: jobLevelID}
+		response := &common.JobLevelResponse{}
+		response.Data.JobLevel = jobLevels[jobLevelID]
+		return response, nil
+	}).Build()
+	Mock(common.JobLevelDeleteAPI).To(func(ctx context.Context, client *common.LarkClient, jobLevelID string) (*common.BaseResponse, error) {
+		delete(jobLevels, jobLevelID)
+		return &common.BaseResponse{}, nil
+	}).Build()
+	defer UnPatchAll()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if len(jobLevels) != 0 {
+				return fmt.Errorf("%d job levels still exist", len(jobLevels))
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read Testing
+			{
+				Config: providerConfig + `resource "lark_job_level" "test" {
+					name        = "Senior"
+					rank        = 3
+					description = "Senior individual contributor"
+					i18n_name = [
+						{
+							locale = "zh_cn"
+							value  = "高级"
+						},
+						{
+							locale = "en_us"
+							value  = "Senior"
+						}
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_job_level.test", "job_level_id", "level_1"),
+					resource.TestCheckResourceAttr("lark_job_level.test", "status", "true"),
+					resource.TestCheckResourceAttr("lark_job_level.test", "i18n_name.#", "2"),
+					resource.TestCheckResourceAttr("lark_job_level.test", "i18n_name.0.value", "高级"),
+					resource.TestCheckNoResourceAttr("lark_job_level.test", "i18n_description"),
+				),
+			},
+
+			// Import Testing
+			{
+				ResourceName:                         "lark_job_level.test",
+				ImportState:                          true,
+				ImportStateId:                        "level_1",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "job_level_id",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+
+			// Update and Read Testing
+			{
+				Config: providerConfig + `resource "lark_job_level" "test" {
+					name   = "Staff"
+					rank   = 4
+					status = false
+					i18n_name = [
+						{
+							locale = "en_us"
+							value  = "Staff"
+						}
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_job_level.test", "job_level_id", "level_1"),
+					resource.TestCheckResourceAttr("lark_job_level.test", "rank", "4"),
+					resource.TestCheckResourceAttr("lark_job_level.test", "description", ""),
+					resource.TestCheckResourceAttr("lark_job_level.test", "i18n_name.#", "1"),
+					func(s *terraform.State) error {
+						jobLevel := jobLevels["level_1"]
+						if jobLevel.Name != "Staff" || jobLevel.Status || len(jobLevel.I18nName) != 1 {
+							return fmt.Errorf("job level level_1 was not updated: %+v", jobLevel)
+						}
+						return nil
+					},
+				),
+			},
+
+			// Drift Testing
+			{
+				PreConfig: func() {
+					jobLevel := jobLevels["level_1"]
+					jobLevel.Rank = 9
+					jobLevels["level_1"] = jobLevel
+				},
+				Config: providerConfig + `resource "lark_job_level" "test" {
+					name   = "Staff"
+					rank   = 4
+					status = false
+					i18n_name = [
+						{
+							locale = "en_us"
+							value  = "Staff"
+						}
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						if jobLevels["level_1"].Rank != 4 {
+							return fmt.Errorf("rank of job level level_1 was not restored")
+						}
+						return nil
+					},
+				),
+			},
+
+			// A failed read keeps the job level in the state
+			{
+				PreConfig: func() {
+					getErr = &common.APIError{Code: 99991400, Msg: "request trigger frequency limit"}
+				},
+				Config: providerConfig + `resource "lark_job_level" "test" {
+					name   = "Staff"
+					rank   = 4
+					status = false
+					i18n_name = [
+						{
+							locale = "en_us"
+							value  = "Staff"
+						}
+					]
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("API Error Reading Job Level"),
+			},
+
+			// Deleted outside of Terraform
+			{
+				PreConfig: func() {
+					getErr = nil
+					delete(jobLevels, "level_1")
+				},
+				Config: providerConfig + `resource "lark_job_level" "test" {
+					name   = "Staff"
+					rank   = 4
+					status = false
+					i18n_name = [
+						{
+							locale = "en_us"
+							value  = "Staff"
+						}
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_job_level.test", "job_level_id", "level_1"),
+				),
+			},
+
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
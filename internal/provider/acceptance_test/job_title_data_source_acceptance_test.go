@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccJobTitleDataSource(t *testing.T) {
+	jobTitles := []common.JobTitle{
+		{
+			JobTitleID: "engineer",
+			Name:       "Engineer",
+			I18nName:   []common.I18nContent{{Locale: "zh_cn", Value: "工程师"}},
+			Status:     true,
+		},
+		{JobTitleID: "manager_1", Name: "Manager", Status: true},
+		{JobTitleID: "manager_2", Name: "Manager", Status: false},
+	}
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.JobTitleGetAPI).To(func(ctx context.Context, client *common.LarkClient, jobTitleID string) (*common.JobTitleGetResponse, error) {
+		i := slices.IndexFunc(jobTitles, func(jobTitle common.JobTitle) bool {
+			return jobTitle.JobTitleID == jobTitleID
+		})
+		if i < 0 {
+			return nil, fmt.Errorf("job title %s not found", jobTitleID)
+		}
+		response := &common.JobTitleGetResponse{}
+		response.Data.JobTitle = jobTitles[i]
+		return response, nil
+	}).Build()
+	Mock(common.JobTitleListAPI).To(func(ctx context.Context, client *common.LarkClient) (*common.JobTitleListResponse, error) {
+		response := &common.JobTitleListResponse{}
+		response.Data.Items = jobTitles
+		return response, nil
+	}).Build()
+	defer UnPatchAll()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `data "lark_job_title" "test" {
+					job_title_id = "engineer"
+					name         = "Engineer"
+				}`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: providerConfig + `data "lark_job_title" "test" {
+					job_title_id = "engineer"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lark_job_title.test", "name", "Engineer"),
+					resource.TestCheckResourceAttr("data.lark_job_title.test", "status", "true"),
+					resource.TestCheckResourceAttr("data.lark_job_title.test", "i18n_name.0.locale", "zh_cn"),
+					resource.TestCheckResourceAttr("data.lark_job_title.test", "i18n_name.0.value", "工程师"),
+				),
+			},
+			{
+				Config: providerConfig + `data "lark_job_title" "test" {
+					name = "Engineer"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lark_job_title.test", "job_title_id", "engineer"),
+				),
+			},
+			{
+				Config: providerConfig + `data "lark_job_title" "test" {
+					name = "Manager"
+				}`,
+				ExpectError: regexp.MustCompile("Ambiguous Job Title Name"),
+			},
+			{
+				Config: providerConfig + `data "lark_job_title" "test" {
+					name = "Director"
+				}`,
+				ExpectError: regexp.MustCompile("Job Title Not Found"),
+			},
+		},
+	})
+}
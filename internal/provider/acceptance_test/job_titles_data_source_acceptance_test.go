@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccJobTitlesDataSource(t *testing.T) {
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.JobTitleListAPI).To(func(ctx context.Context, client *common.LarkClient) (*common.JobTitleListResponse, error) {
+		response := &common.JobTitleListResponse{}
+		response.Data.Items = []common.JobTitle{
+			{
+				JobTitleID: "engineer",
+				Name:       "Engineer",
+				I18nName:   []common.I18nContent{{Locale: "en_us", Value: "Engineer"}},
+				Status:     true,
+			},
+			{JobTitleID: "intern", Name: "Intern", Status: false},
+		}
+		return response, nil
+	}).Build()
+	defer UnPatchAll()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `data "lark_job_titles" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lark_job_titles.test", "enabled_only", "false"),
+					resource.TestCheckResourceAttr("data.lark_job_titles.test", "job_titles.#", "2"),
+					resource.TestCheckResourceAttr("data.lark_job_titles.test", "job_titles.0.i18n_name.0.value", "Engineer"),
+					resource.TestCheckResourceAttr("data.lark_job_titles.test", "job_titles.1.i18n_name.#", "0"),
+					resource.TestCheckResourceAttr("data.lark_job_titles.test", "job_titles.1.status", "false"),
+				),
+			},
+			{
+				Config: providerConfig + `data "lark_job_titles" "test" {
+					enabled_only = true
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lark_job_titles.test", "job_titles.#", "1"),
+					resource.TestCheckResourceAttr("data.lark_job_titles.test", "job_titles.0.job_title_id", "engineer"),
+				),
+			},
+		},
+	})
+}
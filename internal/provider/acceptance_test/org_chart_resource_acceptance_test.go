@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccOrgChartResource(t *testing.T) {
+	departments := []common.Department{
+		{
+			BaseDepartment:   common.BaseDepartment{Name: "Legacy", ParentDepartmentID: "0"},
+			DepartmentID:     "legacy",
+			OpenDepartmentID: "od-legacy",
+		},
+	}
+	indexOf := func(openDepartmentID string) int {
+		return slices.IndexFunc(departments, func(department common.Department) bool {
+			return department.OpenDepartmentID == openDepartmentID
+		})
+	}
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.DepartmentChildrenListAPI).To(func(ctx context.Context, client *common.LarkClient, departmentID string, departmentIDType common.DepartmentIDType, fetchChild bool) (*common.DepartmentListResponse, error) {
+		response := &common.DepartmentListResponse{}
+		response.Data.Items = slices.Clone(departments)
+		return response, nil
+	}).Build()
+	Mock(common.DepartmentGetAPI).To(func(ctx context.Context, client *common.LarkClient, departmentID string, departmentIDType common.DepartmentIDType) (*common.DepartmentGetResponse, error) {
+		return nil, fmt.Errorf("API error when getting department: department not found")
+	}).Build()
+	Mock(common.DepartmentCreateAPI).To(func(ctx context.Context, client *common.LarkClient, request common.DepartmentCreateRequest) (*common.DepartmentGetResponse, error) {
+		department := common.Department{
+			BaseDepartment:   request.BaseDepartment,
+			DepartmentID:     request.DepartmentID,
+			OpenDepartmentID: "od-" + request.DepartmentID,
+		}
+		departments = append(departments, department)
+		response := &common.DepartmentGetResponse{}
+		response.Data.Department = department
+		return response, nil
+	}).Build()
+	Mock(common.DepartmentUpdateAPI).To(func(ctx context.Context, client *common.LarkClient, departmentID string, request common.DepartmentUpdateRequest) (*common.DepartmentGetResponse, error) {
+		i := indexOf(departmentID)
+		if i < 0 {
+			return nil, fmt.Errorf("API error when updating department: department not found")
+		}
+		departments[i].BaseDepartment = request.BaseDepartment
+		response := &common.DepartmentGetResponse{}
+		response.Data.Department = departments[i]
+		return response, nil
+	}).Build()
+	Mock(common.DepartmentDeleteAPI).To(func(ctx context.Context, client *common.LarkClient, departmentID string) (*common.DepartmentDeleteResponse, error) {
+		if slices.ContainsFunc(departments, func(department common.Department) bool {
+			return department.ParentDepartmentID == departmentID
+		}) {
+			return nil, fmt.Errorf("API error when deleting department: department has children")
+		}
+		departments = slices.DeleteFunc(departments, func(department common.Department) bool {
+			return department.OpenDepartmentID == departmentID
+		})
+		return &common.DepartmentDeleteResponse{}, nil
+	}).Build()
+	defer UnPatchAll()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if len(departments) != 0 {
+				return fmt.Errorf("%d departments still exist", len(departments))
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `resource "lark_org_chart" "test" {
+					root_department_id = "0"
+					departments = [
+						{
+							department_id = "engineering"
+							name          = "Engineering"
+							leaders = [
+								{
+									leader_type = 1
+									leader_id   = "ou_leader"
+								}
+							]
+							children = [
+								{
+									department_id = "platform"
+									name          = "Platform"
+								},
+								{
+									department_id = "data"
+									name          = "Data"
+								}
+							]
+						},
+						{
+							department_id = "sales"
+							name          = "Sales"
+						}
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_org_chart.test", "changes.#", "5"),
+					resource.TestCheckResourceAttr("lark_org_chart.test", "changes.0", "create engineering (Engineering) under root"),
+					resource.TestCheckResourceAttr("lark_org_chart.test", "changes.1", "create platform (Platform) under engineering"),
+					resource.TestCheckResourceAttr("lark_org_chart.test", "changes.2", "create data (Data) under engineering"),
+					resource.TestCheckResourceAttr("lark_org_chart.test", "changes.3", "create sales (Sales) under root"),
+					resource.TestCheckResourceAttr("lark_org_chart.test", "changes.4", "delete legacy (Legacy)"),
+					resource.TestCheckResourceAttr("lark_org_chart.test", "departments.0.open_department_id", "od-engineering"),
+					resource.TestCheckResourceAttr("lark_org_chart.test", "departments.0.children.1.open_department_id", "od-data"),
+					resource.TestCheckResourceAttr("lark_org_chart.test", "departments.0.leaders.0.leader_id", "ou_leader"),
+					func(s *terraform.State) error {
+						if i := indexOf("od-platform"); i < 0 || departments[i].ParentDepartmentID != "od-engineering" {
+							return fmt.Errorf("platform was not created under engineering")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: providerConfig + `resource "lark_org_chart" "test" {
+					root_department_id = "0"
+					departments = [
+						{
+							department_id = "engineering"
+							name          = "Engineering"
+						},
+						{
+							department_id = "platform"
+							name          = "Platform"
+						},
+						{
+							department_id = "sales"
+							name          = "Sales & Marketing"
+						}
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_org_chart.test", "changes.#", "3"),
+					resource.TestCheckResourceAttr("lark_org_chart.test", "changes.0", "move platform from engineering to root"),
+					resource.TestCheckResourceAttr("lark_org_chart.test", "changes.1", "rename sales from Sales to Sales & Marketing"),
+					resource.TestCheckResourceAttr("lark_org_chart.test", "changes.2", "delete data (Data)"),
+					resource.TestCheckNoResourceAttr("lark_org_chart.test", "departments.0.children.#"),
+					resource.TestCheckResourceAttr("lark_org_chart.test", "departments.1.open_department_id", "od-platform"),
+					func(s *terraform.State) error {
+						if i := indexOf("od-platform"); i < 0 || departments[i].ParentDepartmentID != "0" {
+							return fmt.Errorf("platform was not moved to the root")
+						}
+						if indexOf("od-data") >= 0 {
+							return fmt.Errorf("data was not deleted")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:                         "lark_org_chart.test",
+				ImportState:                          true,
+				ImportStateId:                        "0",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "root_department_id",
+				ImportStateVerifyIgnore:              []string{"changes", "last_updated"},
+			},
+		},
+	})
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccRoleMemberBindingResource(t *testing.T) {
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.GetUsersByIDAPI).Return(&common.UserInfoBatchGetResponse{
+		Data: struct {
+			Items []common.User `json:"items"`
+		}{
+			Items: []common.User{
+				{UserID: "0"},
+			},
+		},
+	}, nil).Build()
+
+	// ou_other is managed by someone else and must never be touched.
+	memberList := []string{"ou_other"}
+	Mock(common.RoleMemberAddAPI).To(func(ctx context.Context, client *common.LarkClient, roleID string, req common.RoleMemberCreateRequest) (*common.RoleMemberCreateResponse, error) {
+		memberList = append(memberList, req.Members...)
+		results := []common.FunctionalRoleMemberResult{}
+		for _, member := range req.Members {
+			results = append(results, common.FunctionalRoleMemberResult{UserID: member, Reason: 1})
+		}
+		return &common.RoleMemberCreateResponse{
+			Data: common.DataRoleMemberCreateDeleteResponse{Results: results},
+		}, nil
+	}).Build()
+
+	Mock(common.RoleMemberDeleteAPI).To(func(ctx context.Context, client *common.LarkClient, roleID string, req common.RoleMemberDeleteRequest) (*common.RoleMemberDeleteResponse, error) {
+		memberList = slices.DeleteFunc(memberList, func(member string) bool {
+			return slices.Contains(req.Members, member)
+		})
+		results := []common.FunctionalRoleMemberResult{}
+		for _, member := range req.Members {
+			results = append(results, common.FunctionalRoleMemberResult{UserID: member, Reason: 1})
+		}
+		return &common.RoleMemberDeleteResponse{
+			Data: common.DataRoleMemberCreateDeleteResponse{Results: results},
+		}, nil
+	}).Build()
+
+	Mock(common.RoleMemberGetAPI).To(func(ctx context.Context, client *common.LarkClient, roleID string) (*common.RoleMemberGetResponse, error) {
+		response := &common.RoleMemberGetResponse{}
+		for _, member := range memberList {
+			response.Data.Members = append(response.Data.Members, common.RoleMember{UserID: member})
+		}
+		return response, nil
+	}).Build()
+	defer UnPatchAll()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read Testing
+			{
+				Config: providerConfig + `resource "lark_role_member_binding" "test" {
+					role_id   = "role_test"
+					member_id = "ou_0"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_role_member_binding.test", "role_id", "role_test"),
+					resource.TestCheckResourceAttr("lark_role_member_binding.test", "member_id", "ou_0"),
+				),
+			},
+
+			// Import Testing
+			{
+				ResourceName:            "lark_role_member_binding.test",
+				ImportState:             true,
+				ImportStateId:           "role_test:ou_0",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"id", "last_updated"},
+			},
+
+			// Replace and Read Testing
+			{
+				Config: providerConfig + `resource "lark_role_member_binding" "test" {
+					role_id   = "role_test"
+					member_id = "ou_1"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_role_member_binding.test", "role_id", "role_test"),
+					resource.TestCheckResourceAttr("lark_role_member_binding.test", "member_id", "ou_1"),
+					func(s *terraform.State) error {
+						if !slices.Contains(memberList, "ou_other") {
+							return fmt.Errorf("member ou_other should not be removed from the role")
+						}
+						return nil
+					},
+				),
+			},
+
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUnitDataSource(t *testing.T) {
+	units := []common.Unit{
+		{BaseUnit: common.BaseUnit{Name: "Asia", UnitType: "subsidiary"}, UnitID: "asia"},
+		{BaseUnit: common.BaseUnit{Name: "Europe", UnitType: "subsidiary"}, UnitID: "europe_1"},
+		{BaseUnit: common.BaseUnit{Name: "Europe", UnitType: "subsidiary"}, UnitID: "europe_2"},
+	}
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.UnitGetAPI).To(func(ctx context.Context, client *common.LarkClient, unitID string) (*common.UnitGetResponse, error) {
+		i := slices.IndexFunc(units, func(unit common.Unit) bool {
+			return unit.UnitID == unitID
+		})
+		if i < 0 {
+			return nil, fmt.Errorf("unit %s not found", unitID)
+		}
+		response := &common.UnitGetResponse{}
+		response.Data.Unit = units[i]
+		return response, nil
+	}).Build()
+	Mock(common.UnitListAPI).To(func(ctx context.Context, client *common.LarkClient) (*common.UnitListResponse, error) {
+		response := &common.UnitListResponse{}
+		response.Data.UnitList = units
+		return response, nil
+	}).Build()
+	Mock(common.UnitDepartmentListAPI).To(func(ctx context.Context, client *common.LarkClient, unitID string, departmentIDType common.DepartmentIDType) (*common.UnitDepartmentListResponse, error) {
+		response := &common.UnitDepartmentListResponse{}
+		if unitID == "asia" {
+			response.Data.DepartmentList = []common.UnitDepartment{
+				{UnitID: "asia", DepartmentID: "od-engineering"},
+				{UnitID: "asia", DepartmentID: "od-sales"},
+			}
+		}
+		return response, nil
+	}).Build()
+	defer UnPatchAll()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `data "lark_unit" "test" {
+					unit_id = "asia"
+					name    = "Asia"
+				}`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: providerConfig + `data "lark_unit" "test" {
+					unit_id = "asia"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lark_unit.test", "name", "Asia"),
+					resource.TestCheckResourceAttr("data.lark_unit.test", "unit_type", "subsidiary"),
+					resource.TestCheckResourceAttr("data.lark_unit.test", "department_ids.#", "2"),
+					resource.TestCheckResourceAttr("data.lark_unit.test", "department_ids.1", "od-sales"),
+				),
+			},
+			{
+				Config: providerConfig + `data "lark_unit" "test" {
+					name = "Asia"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lark_unit.test", "unit_id", "asia"),
+					resource.TestCheckResourceAttr("data.lark_unit.test", "department_ids.0", "od-engineering"),
+				),
+			},
+			{
+				Config: providerConfig + `data "lark_unit" "test" {
+					name = "Europe"
+				}`,
+				ExpectError: regexp.MustCompile("Ambiguous Unit Name"),
+			},
+			{
+				Config: providerConfig + `data "lark_unit" "test" {
+					name = "Africa"
+				}`,
+				ExpectError: regexp.MustCompile("Unit Not Found"),
+			},
+		},
+	})
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccUnitDepartmentBindingResource(t *testing.T) {
+	// od-other is bound by someone else and must never be touched.
+	bindings := []common.UnitDepartment{{UnitID: "asia", DepartmentID: "od-other"}}
+	bound := func(unitID, departmentID string) bool {
+		return slices.Contains(bindings, common.UnitDepartment{UnitID: unitID, DepartmentID: departmentID})
+	}
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.UnitBindDepartmentAPI).To(func(ctx context.Context, client *common.LarkClient, request common.UnitDepartmentRequest) (*common.BaseResponse, error) {
+		if bound(request.UnitID, request.DepartmentID) {
+			return nil, fmt.Errorf("API error when binding department to unit: department already bound")
+		}
+		bindings = append(bindings, common.UnitDepartment{UnitID: request.UnitID, DepartmentID: request.DepartmentID})
+		return &common.BaseResponse{}, nil
+	}).Build()
+	Mock(common.UnitUnbindDepartmentAPI).To(func(ctx context.Context, client *common.LarkClient, request common.UnitDepartmentRequest) (*common.BaseResponse, error) {
+		bindings = slices.DeleteFunc(bindings, func(binding common.UnitDepartment) bool {
+			return binding.UnitID == request.UnitID && binding.DepartmentID == request.DepartmentID
+		})
+		return &common.BaseResponse{}, nil
+	}).Build()
+	Mock(common.UnitDepartmentListAPI).To(func(ctx context.Context, client *common.LarkClient, unitID string, departmentIDType common.DepartmentIDType) (*common.UnitDepartmentListResponse, error) {
+		response := &common.UnitDepartmentListResponse{}
+		for _, binding := range bindings {
+			if binding.UnitID == unitID {
+				response.Data.DepartmentList = append(response.Data.DepartmentList, binding)
+			}
+		}
+		return response, nil
+	}).Build()
+	defer UnPatchAll()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if len(bindings) != 1 || !bound("asia", "od-other") {
+				return fmt.Errorf("expected only od-other to stay bound, got %v", bindings)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read Testing
+			{
+				Config: providerConfig + `resource "lark_unit_department_binding" "test" {
+					unit_id       = "asia"
+					department_id = "od-engineering"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_unit_department_binding.test", "unit_id", "asia"),
+					resource.TestCheckResourceAttr("lark_unit_department_binding.test", "department_id", "od-engineering"),
+					resource.TestCheckResourceAttr("lark_unit_department_binding.test", "department_id_type", "open_department_id"),
+				),
+			},
+
+			// Import Testing
+			{
+				ResourceName:            "lark_unit_department_binding.test",
+				ImportState:             true,
+				ImportStateId:           "asia:od-engineering",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"id", "last_updated"},
+			},
+
+			// Replace and Read Testing
+			{
+				Config: providerConfig + `resource "lark_unit_department_binding" "test" {
+					unit_id       = "asia"
+					department_id = "od-sales"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_unit_department_binding.test", "department_id", "od-sales"),
+					func(s *terraform.State) error {
+						if bound("asia", "od-engineering") {
+							return fmt.Errorf("od-engineering should be unbound from the unit")
+						}
+						if !bound("asia", "od-other") {
+							return fmt.Errorf("od-other should not be unbound from the unit")
+						}
+						return nil
+					},
+				),
+			},
+
+			// Unbound outside of Terraform Testing
+			{
+				PreConfig: func() {
+					bindings = slices.DeleteFunc(bindings, func(binding common.UnitDepartment) bool {
+						return binding.DepartmentID == "od-sales"
+					})
+				},
+				Config: providerConfig + `resource "lark_unit_department_binding" "test" {
+					unit_id       = "asia"
+					department_id = "od-sales"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						if !bound("asia", "od-sales") {
+							return fmt.Errorf("od-sales should be bound to the unit again")
+						}
+						return nil
+					},
+				),
+			},
+
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccUnitResource(t *testing.T) {
+	units := map[string]common.Unit{}
+	created := 0
+	var getErr error
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.UnitCreateAPI).To(func(ctx context.Context, client *common.LarkClient, request common.UnitCreateRequest) (*common.UnitCreateResponse, error) {
+		unitID := request.UnitID
+		if unitID == "" {
+			created++
+			unitID = fmt.Sprintf("generated_%d", created)
+		}
+		if _, ok := units[unitID]; ok {
+			return nil, fmt.Errorf("API error when creating unit: unit id already exists")
+		}
+		units[unitID] = common.Unit{BaseUnit: request.BaseUnit, UnitID: unitID}
+		response := &common.UnitCreateResponse{}
+		response.Data.UnitID = unitID
+		return response, nil
+	}).Build()
+	Mock(common.UnitGetAPI).To(func(ctx context.Context, client *common.LarkClient, unitID string) (*common.UnitGetResponse, error) {
+		if getErr != nil {
+			return nil, getErr
+		}
+		unit, ok := units[unitID]
+		if !ok {
+			return nil, fmt.Errorf("API error when getting unit: %w", &common.APIError{Code: common.UNIT_NOT_FOUND_CODE, Msg: "unit not found"})
+		}
+		response := &common.UnitGetResponse{}
+		response.Data.Unit = unit
+		return response, nil
+	}).Build()
+	Mock(common.UnitUpdateAPI).To(func(ctx context.Context, client *common.LarkClient, unitID string, request common.UnitUpdateRequest) (*common.BaseResponse, error) {
+		unit, ok := units[unitID]
+		if !ok {
+			return nil, fmt.Errorf("API error when updating unit: unit not found")
+		}
+		unit.Name = request.Name
+		units[unitID] = unit
+		return &common.BaseResponse{}, nil
+	}).Build()
+	Mock(common.UnitDeleteAPI).To(func(ctx context.Context, client *common.LarkClient, unitID string) (*common.BaseResponse, error) {
+		delete(units, unitID)
+		return &common.BaseResponse{}, nil
+	}).Build()
+	defer UnPatchAll()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if len(units) != 0 {
+				return fmt.Errorf("%d units still exist", len(units))
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read Testing
+			{
+				Config: providerConfig + `resource "lark_unit" "test" {
+					unit_id   = "asia"
+					name      = "Asia"
+					unit_type = "subsidiary"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_unit.test", "unit_id", "asia"),
+					resource.TestCheckResourceAttr("lark_unit.test", "name", "Asia"),
+					resource.TestCheckResourceAttr("lark_unit.test", "unit_type", "subsidiary"),
+				),
+			},
+
+			// Import Testing
+			{
+				ResourceName:                         "lark_unit.test",
+				ImportState:                          true,
+				ImportStateId:                        "asia",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "unit_id",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+
+			// Update and Read Testing
+			{
+				Config: providerConfig + `resource "lark_unit" "test" {
+					unit_id   = "asia"
+					name      = "Asia Pacific"
+					unit_type = "subsidiary"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_unit.test", "name", "Asia Pacific"),
+					func(s *terraform.State) error {
+						if units["asia"].Name != "Asia Pacific" {
+							return fmt.Errorf("unit asia was not renamed")
+						}
+						return nil
+					},
+				),
+			},
+
+			// A failed read keeps the unit in the state
+			{
+				PreConfig: func() {
+					getErr = &common.APIError{Code: 99991400, Msg: "request trigger frequency limit"}
+				},
+				Config: providerConfig + `resource "lark_unit" "test" {
+					unit_id   = "asia"
+					name      = "Asia Pacific"
+					unit_type = "subsidiary"
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("API Error Reading Unit"),
+			},
+
+			// Deleted outside of Terraform
+			{
+				PreConfig: func() {
+					getErr = nil
+					delete(units, "asia")
+				},
+				Config: providerConfig + `resource "lark_unit" "test" {
+					unit_id   = "asia"
+					name      = "Asia Pacific"
+					unit_type = "subsidiary"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						if units["asia"].Name != "Asia Pacific" {
+							return fmt.Errorf("unit asia was not created again")
+						}
+						return nil
+					},
+				),
+			},
+
+			// Replace and Read Testing
+			{
+				Config: providerConfig + `resource "lark_unit" "test" {
+					name      = "Asia Pacific"
+					unit_type = "business unit"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_unit.test", "unit_id", "generated_1"),
+					resource.TestCheckResourceAttr("lark_unit.test", "unit_type", "business unit"),
+					func(s *terraform.State) error {
+						if _, ok := units["asia"]; ok {
+							return fmt.Errorf("unit asia was not replaced")
+						}
+						return nil
+					},
+				),
+			},
+
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserDataSource(t *testing.T) {
+	user := common.User{
+		OpenID:        "ou_1",
+		UserID:        "u_1",
+		UnionID:       "on_1",
+		Name:          "Jane Doe",
+		Email:         "jane.doe@example.com",
+		Avatar:        common.AvatarInfo{Avatar72: "https://example.com/72.png"},
+		Status:        common.UserStatus{IsActivated: true},
+		DepartmentIDs: []string{"od-engineering"},
+		Orders:        []common.Order{{DepartmentID: "od-engineering", UserOrder: 10, IsPrimaryDept: true}},
+		LeaderUserID:  "ou_manager",
+		EmployeeType:  1,
+		JobTitle:      "Engineer",
+		City:          "Jakarta",
+		CustomAttrs: []common.CustomAttr{
+			{ID: "C-1", Type: "GENERIC_USER", Value: common.CustomAttrValue{GenericUser: &common.CustomAttrGenericUser{ID: "ou_approver", Type: 1}}},
+		},
+		DepartmentPath: []common.DepartmentDetail{
+			{
+				DepartmentID:   "od-engineering",
+				DepartmentName: common.DepartmentPathName{Name: "Engineering"},
+				DepartmentPath: common.DepartmentPath{
+					DepartmentIDs:      []string{"od-engineering"},
+					DepartmentPathName: common.DepartmentPathName{Name: "Engineering"},
+				},
+			},
+		},
+	}
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.UserGetAPI).To(func(ctx context.Context, client *common.LarkClient, userID string, userIDType common.UserIDType) (*common.UserGetResponse, error) {
+		if (userIDType == common.OPEN_ID && userID != user.OpenID) || (userIDType == common.USER_ID && userID != user.UserID) {
+			return nil, fmt.Errorf("user %s not found", userID)
+		}
+		response := &common.UserGetResponse{}
+		response.Data.User = user
+		return response, nil
+	}).Build()
+	defer UnPatchAll()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `data "lark_user" "test" {
+					open_id = "ou_1"
+					user_id = "u_1"
+				}`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: providerConfig + `data "lark_user" "test" {
+					open_id = "ou_1"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lark_user.test", "user_id", "u_1"),
+					resource.TestCheckResourceAttr("data.lark_user.test", "name", "Jane Doe"),
+					resource.TestCheckResourceAttr("data.lark_user.test", "avatar.avatar_72", "https://example.com/72.png"),
+					resource.TestCheckResourceAttr("data.lark_user.test", "status.is_activated", "true"),
+					resource.TestCheckResourceAttr("data.lark_user.test", "department_ids.0", "od-engineering"),
+					resource.TestCheckResourceAttr("data.lark_user.test", "orders.0.user_order", "10"),
+					resource.TestCheckResourceAttr("data.lark_user.test", "orders.0.is_primary_dept", "true"),
+					resource.TestCheckResourceAttr("data.lark_user.test", "leader_user_id", "ou_manager"),
+					resource.TestCheckResourceAttr("data.lark_user.test", "custom_attrs.0.generic_user_id", "ou_approver"),
+					resource.TestCheckResourceAttr("data.lark_user.test", "department_paths.0.department_name", "Engineering"),
+					resource.TestCheckResourceAttr("data.lark_user.test", "department_paths.0.path_department_ids.#", "1"),
+				),
+			},
+			{
+				Config: providerConfig + `data "lark_user" "test" {
+					user_id = "u_1"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lark_user.test", "open_id", "ou_1"),
+					resource.TestCheckResourceAttr("data.lark_user.test", "job_title", "Engineer"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccUserGroupMemberBindingResource(t *testing.T) {
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.GetUsersByIDAPI).Return(&common.UserInfoBatchGetResponse{
+		Data: struct {
+			Items []common.User `json:"items"`
+		}{
+			Items: []common.User{
+				{UserID: "0"},
+			},
+		},
+	}, nil).Build()
+
+	// ou_other is managed by someone else and must never be touched.
+	memberList := []string{"ou_other"}
+	Mock(common.UsergroupMemberAddAPI).To(func(ctx context.Context, client *common.LarkClient, groupID string, req common.UsergroupMemberAddRequest) (*common.UsergroupMemberAddResponse, error) {
+		for _, member := range req.Members {
+			memberList = append(memberList, member.MemberID)
+		}
+		return &common.UsergroupMemberAddResponse{}, nil
+	}).Build()
+
+	Mock(common.UsergroupMemberRemoveAPI).To(func(ctx context.Context, client *common.LarkClient, groupID string, req common.UsergroupMemberRemoveRequest) (*common.BaseResponse, error) {
+		memberList = slices.DeleteFunc(memberList, func(member string) bool {
+			return slices.ContainsFunc(req.Members, func(removed common.UsergroupMember) bool {
+				return removed.MemberID == member
+			})
+		})
+		return &common.BaseResponse{}, nil
+	}).Build()
+
+	Mock(common.UsergroupMemberGetByMemberTypeAPI).To(func(ctx context.Context, client *common.LarkClient, groupID string, memberType string) (*common.UsergroupMemberGetResponse, error) {
+		response := &common.UsergroupMemberGetResponse{}
+		for _, member := range memberList {
+			response.Data.MemberList = append(response.Data.MemberList, common.UsergroupMember{MemberID: member})
+		}
+		return response, nil
+	}).Build()
+	defer UnPatchAll()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read Testing
+			{
+				Config: providerConfig + `resource "lark_user_group_member_binding" "test" {
+					user_group_id = "ug_test"
+					member_id     = "ou_0"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_user_group_member_binding.test", "user_group_id", "ug_test"),
+					resource.TestCheckResourceAttr("lark_user_group_member_binding.test", "member_id", "ou_0"),
+				),
+			},
+
+			// Import Testing
+			{
+				ResourceName:            "lark_user_group_member_binding.test",
+				ImportState:             true,
+				ImportStateId:           "ug_test:ou_0",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"id", "last_updated"},
+			},
+
+			// Replace and Read Testing
+			{
+				Config: providerConfig + `resource "lark_user_group_member_binding" "test" {
+					user_group_id = "ug_test"
+					member_id     = "ou_1"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_user_group_member_binding.test", "user_group_id", "ug_test"),
+					resource.TestCheckResourceAttr("lark_user_group_member_binding.test", "member_id", "ou_1"),
+					func(s *terraform.State) error {
+						if !slices.Contains(memberList, "ou_other") {
+							return fmt.Errorf("member ou_other should not be removed from the user group")
+						}
+						return nil
+					},
+				),
+			},
+
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_acceptance_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccUserResource(t *testing.T) {
+	users := map[string]*common.User{}
+	var deleteRequest *common.UserDeleteRequest
+
+	saveUser := func(user *common.User, request common.BaseUser) {
+		user.Name = request.Name
+		user.EnName = request.EnName
+		user.Email = request.Email
+		// Lark adds the country code to mainland China numbers.
+		user.Mobile = "+86" + request.Mobile
+		user.DepartmentIDs = request.DepartmentIDs
+		user.LeaderUserID = request.LeaderUserID
+		user.City = request.City
+		user.EmployeeType = request.EmployeeType
+		user.JobTitle = request.JobTitle
+		user.Orders = request.Orders
+		user.CustomAttrs = request.CustomAttrs
+	}
+
+	Mock(common.GetAccessTokenAPI).Return("test_tenant_access_token", "test_app_access_token", nil).Build()
+	Mock(common.GetUsersByIDAPI).Return(&common.UserInfoBatchGetResponse{
+		Data: struct {
+			Items []common.User `json:"items"`
+		}{
+			Items: []common.User{
+				{UserID: "0"},
+			},
+		},
+	}, nil).Build()
+	Mock(common.UserCreateAPI).To(func(ctx context.Context, client *common.LarkClient, request common.UserCreateRequest) (*common.UserGetResponse, error) {
+		user := &common.User{UserID: request.UserID, OpenID: "ou_1", UnionID: "on_1"}
+		if user.UserID == "" {
+			user.UserID = "generated"
+		}
+		saveUser(user, request.BaseUser)
+		users[user.OpenID] = user
+
+		response := &common.UserGetResponse{}
+		response.Data.User = *user
+		return response, nil
+	}).Build()
+	Mock(common.UserGetAPI).To(func(ctx context.Context, client *common.LarkClient, userID string, userIDType common.UserIDType) (*common.UserGetResponse, error) {
+		user, ok := users[userID]
+		if !ok {
+			return nil, fmt.Errorf("API error when getting user: %w", &common.APIError{Code: common.USER_NOT_FOUND_CODE, Msg: "user not found"})
+		}
+		response := &common.UserGetResponse{}
+		response.Data.User = *user
+		return response, nil
+	}).Build()
+	Mock(common.UserUpdateAPI).To(func(ctx context.Context, client *common.LarkClient, openID string, request common.UserUpdateRequest) (*common.UserGetResponse, error) {
+		user := users[openID]
+		saveUser(user, request.BaseUser)
+
+		response := &common.UserGetResponse{}
+		response.Data.User = *user
+		return response, nil
+	}).Build()
+	Mock(common.UserDeleteAPI).To(func(ctx context.Context, client *common.LarkClient, openID string, request common.UserDeleteRequest) (*common.BaseResponse, error) {
+		users[openID].Status.IsResigned = true
+		deleteRequest = &request
+		return &common.BaseResponse{}, nil
+	}).Build()
+	defer UnPatchAll()
+
+	updatedConfig := providerConfig + `
+	resource "lark_user" "test" {
+		name           = "Test User"
+		email          = "test@example.com"
+		mobile         = "13800000000"
+		employee_type  = 2
+		job_title      = "Engineer"
+		city           = "Jakarta"
+		leader_user_id = "ou_manager"
+		departments = [
+			{
+				department_id = "0"
+			},
+			{
+				department_id   = "od-engineering"
+				user_order      = 10
+				is_primary_dept = true
+			}
+		]
+		custom_attrs = [
+			{
+				id   = "C-1"
+				type = "TEXT"
+				text = "Remote"
+			}
+		]
+
+		docs_acceptor_user_id            = "ou_manager"
+		department_chat_acceptor_user_id = "ou_manager"
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if !users["ou_1"].Status.IsResigned {
+				return fmt.Errorf("expected the user to be resigned")
+			}
+			if deleteRequest.DocsAcceptorUserID != "ou_manager" || deleteRequest.DepartmentChatAcceptorUserID != "ou_manager" {
+				return fmt.Errorf("expected the docs and chats to be handed over, got %+v", deleteRequest)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read Testing
+			{
+				Config: providerConfig + `
+				resource "lark_user" "test" {
+					name          = "Test User"
+					mobile        = "13800000000"
+					employee_type = 1
+					departments = [
+						{
+							department_id = "0"
+						}
+					]
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_user.test", "open_id", "ou_1"),
+					resource.TestCheckResourceAttr("lark_user.test", "user_id", "generated"),
+					resource.TestCheckResourceAttr("lark_user.test", "mobile", "13800000000"),
+					resource.TestCheckResourceAttr("lark_user.test", "departments.#", "1"),
+					resource.TestCheckResourceAttr("lark_user.test", "departments.0.is_primary_dept", "true"),
+					resource.TestCheckResourceAttr("lark_user.test", "departments.0.user_order", "0"),
+					resource.TestCheckNoResourceAttr("lark_user.test", "job_title"),
+				),
+			},
+			// Update and Read Testing
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lark_user.test", "open_id", "ou_1"),
+					resource.TestCheckResourceAttr("lark_user.test", "employee_type", "2"),
+					resource.TestCheckResourceAttr("lark_user.test", "job_title", "Engineer"),
+					resource.TestCheckResourceAttr("lark_user.test", "departments.#", "2"),
+					resource.TestCheckResourceAttr("lark_user.test", "departments.0.is_primary_dept", "false"),
+					resource.TestCheckResourceAttr("lark_user.test", "departments.1.is_primary_dept", "true"),
+					resource.TestCheckResourceAttr("lark_user.test", "departments.1.user_order", "10"),
+					resource.TestCheckResourceAttr("lark_user.test", "custom_attrs.0.text", "Remote"),
+				),
+			},
+			// Departments reordered in Lark keep the configured order
+			{
+				PreConfig: func() {
+					slices.Reverse(users["ou_1"].Orders)
+				},
+				Config:   updatedConfig,
+				PlanOnly: true,
+			},
+			// ImportState Testing
+			{
+				ResourceName:                         "lark_user.test",
+				ImportState:                          true,
+				ImportStateId:                        "ou_1",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "open_id",
+				ImportStateVerifyIgnore: []string{
+					"last_updated",
+					"mobile",
+					"custom_attrs",
+					"docs_acceptor_user_id",
+					"department_chat_acceptor_user_id",
+				},
+			},
+			// A user deleted in Lark is removed from the state
+			{
+				PreConfig: func() {
+					delete(users, "ou_1")
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: func(s *terraform.State) error {
+					if _, ok := s.RootModule().Resources["lark_user.test"]; ok {
+						return fmt.Errorf("expected the user to be removed from the state")
+					}
+					return nil
+				},
+			},
+			// The deleted user is created again
+			{
+				Config: updatedConfig,
+				Check:  resource.TestCheckResourceAttr("lark_user.test", "open_id", "ou_1"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
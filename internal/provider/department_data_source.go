@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DepartmentDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &DepartmentDataSource{}
+
+func NewDepartmentDataSource() datasource.DataSource {
+	return &DepartmentDataSource{}
+}
+
+// DepartmentDataSource defines the data source implementation.
+type DepartmentDataSource struct {
+	client *common.LarkClient
+}
+
+// DepartmentDataSourceModel describes the data source data model.
+type DepartmentDataSourceModel struct {
+	BaseResourceModel
+	DepartmentID           types.String   `tfsdk:"department_id"`
+	OpenDepartmentID       types.String   `tfsdk:"open_department_id"`
+	Name                   types.String   `tfsdk:"name"`
+	ParentDepartmentID     types.String   `tfsdk:"parent_department_id"`
+	I18nName               *I18nName      `tfsdk:"i18n_name"`
+	LeaderUserID           types.String   `tfsdk:"leader_user_id"`
+	Order                  types.String   `tfsdk:"order"`
+	UnitIDs                []types.String `tfsdk:"unit_ids"`
+	ChatID                 types.String   `tfsdk:"chat_id"`
+	Leaders                []Leaders      `tfsdk:"leaders"`
+	GroupChatEmployeeTypes []types.Int64  `tfsdk:"group_chat_employee_types"`
+	MemberCount            types.Int64    `tfsdk:"member_count"`
+}
+
+func (d *DepartmentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_department"
+}
+
+func (d *DepartmentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"department_id": schema.StringAttribute{
+			Description:         "Custom department ID of the department to look up. Exactly one of department_id, open_department_id and name must be set.",
+			MarkdownDescription: "Custom department ID of the department to look up. Exactly one of `department_id`, `open_department_id` and `name` must be set.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"open_department_id": schema.StringAttribute{
+			Description:         "Open department ID of the department to look up. Exactly one of department_id, open_department_id and name must be set.",
+			MarkdownDescription: "Open department ID of the department to look up. Exactly one of `department_id`, `open_department_id` and `name` must be set.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			Description:         "Exact name of the department to look up among the children of parent_department_id. Exactly one of department_id, open_department_id and name must be set.",
+			MarkdownDescription: "Exact name of the department to look up among the children of `parent_department_id`. Exactly one of `department_id`, `open_department_id` and `name` must be set.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"parent_department_id": schema.StringAttribute{
+			Description:         "Parent department ID, of the same type as the ID used for the lookup. Required with name, as an open department ID, 0 for the root department.",
+			MarkdownDescription: "Parent department ID, of the same type as the ID used for the lookup. Required with `name`, as an open department ID, `0` for the root department.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"i18n_name": schema.SingleNestedAttribute{
+			Description:         "Internationalized department name.",
+			MarkdownDescription: "Internationalized department name.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"zh_cn": schema.StringAttribute{
+					Description:         "Department's Chinese name.",
+					MarkdownDescription: "Department's Chinese name.",
+					Computed:            true,
+				},
+				"ja_jp": schema.StringAttribute{
+					Description:         "Department's Japanese name.",
+					MarkdownDescription: "Department's Japanese name.",
+					Computed:            true,
+				},
+				"en_us": schema.StringAttribute{
+					Description:         "Department's English name.",
+					MarkdownDescription: "Department's English name.",
+					Computed:            true,
+				},
+			},
+		},
+		"leader_user_id": schema.StringAttribute{
+			Description:         "Department manager's user ID.",
+			MarkdownDescription: "Department manager's user ID.",
+			Computed:            true,
+		},
+		"order": schema.StringAttribute{
+			Description:         "Department order among the departments at the same level.",
+			MarkdownDescription: "Department order among the departments at the same level.",
+			Computed:            true,
+		},
+		"unit_ids": schema.ListAttribute{
+			Description:         "List of the department unit's custom IDs.",
+			MarkdownDescription: "List of the department unit's custom IDs.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"chat_id": schema.StringAttribute{
+			Description:         "Department group chat ID.",
+			MarkdownDescription: "Department group chat ID.",
+			Computed:            true,
+		},
+		"leaders": departmentLeadersDataSourceAttribute(),
+		"group_chat_employee_types": schema.ListAttribute{
+			Description:         "Department group employee type restriction.",
+			MarkdownDescription: "Department group employee type restriction.",
+			Computed:            true,
+			ElementType:         types.Int64Type,
+		},
+		"member_count": schema.Int64Attribute{
+			Description:         "Number of users under the department.",
+			MarkdownDescription: "Number of users under the department.",
+			Computed:            true,
+		},
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Retrieve a department in Lark by ID or by name under a parent department",
+		MarkdownDescription: "Retrieve a department in Lark by ID or by name under a parent department",
+		Attributes:          attributes,
+	}
+}
+
+func (d *DepartmentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DepartmentDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("department_id"),
+			path.MatchRoot("open_department_id"),
+			path.MatchRoot("name"),
+		),
+		datasourcevalidator.RequiredTogether(
+			path.MatchRoot("name"),
+			path.MatchRoot("parent_department_id"),
+		),
+	}
+}
+
+func (d *DepartmentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DepartmentDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var department common.Department
+	switch {
+	case !data.DepartmentID.IsNull():
+		response, err := common.DepartmentGetAPI(ctx, d.client, data.DepartmentID.ValueString(), common.DEPARTMENT_ID)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Reading Department", err.Error())
+			return
+		}
+		department = response.Data.Department
+	case !data.OpenDepartmentID.IsNull():
+		response, err := common.DepartmentGetAPI(ctx, d.client, data.OpenDepartmentID.ValueString(), common.OPEN_DEPARTMENT_ID)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Reading Department", err.Error())
+			return
+		}
+		department = response.Data.Department
+	default:
+		response, err := common.DepartmentChildrenListAPI(ctx, d.client, data.ParentDepartmentID.ValueString(), common.OPEN_DEPARTMENT_ID, false)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Listing Department Children", err.Error())
+			return
+		}
+
+		departments := []common.Department{}
+		for _, child := range response.Data.Items {
+			if child.Name == data.Name.ValueString() {
+				departments = append(departments, child)
+			}
+		}
+
+		if len(departments) == 0 {
+			resp.Diagnostics.AddError(
+				"Department Not Found",
+				fmt.Sprintf("No department named %s under department %s", data.Name.ValueString(), data.ParentDepartmentID.ValueString()),
+			)
+			return
+		}
+		if len(departments) > 1 {
+			openDepartmentIDs := []string{}
+			for _, department := range departments {
+				openDepartmentIDs = append(openDepartmentIDs, department.OpenDepartmentID)
+			}
+			resp.Diagnostics.AddError(
+				"Ambiguous Department Name",
+				fmt.Sprintf("%d departments are named %s: %s, use open_department_id instead", len(departments), data.Name.ValueString(), strings.Join(openDepartmentIDs, ", ")),
+			)
+			return
+		}
+		department = departments[0]
+	}
+
+	data.DepartmentID = types.StringValue(department.DepartmentID)
+	data.OpenDepartmentID = types.StringValue(department.OpenDepartmentID)
+	data.Name = types.StringValue(department.Name)
+	data.ParentDepartmentID = types.StringValue(department.ParentDepartmentID)
+	data.I18nName = &I18nName{
+		ZhCn: types.StringValue(department.I18nName.ZhCn),
+		JaJp: types.StringValue(department.I18nName.JaJp),
+		EnUs: types.StringValue(department.I18nName.EnUs),
+	}
+	data.LeaderUserID = types.StringValue(department.LeaderUserID)
+	data.Order = types.StringValue(department.Order)
+	data.UnitIDs = common.StringsToStringValues(department.UnitIDs)
+	data.ChatID = types.StringValue(department.ChatID)
+	data.Leaders = departmentLeadersToModel(department.Leaders)
+	data.GroupChatEmployeeTypes = []types.Int64{}
+	for _, employeeType := range department.GroupChatEmployeeTypes {
+		data.GroupChatEmployeeTypes = append(data.GroupChatEmployeeTypes, types.Int64Value(employeeType))
+	}
+	data.MemberCount = types.Int64Value(int64(department.MemberCount))
+
+	data.Id = types.StringValue(common.ConstructID(common.DATA_SOURCE, common.DEPARTMENT, department.OpenDepartmentID))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func departmentLeadersDataSourceAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description:         "Head of department.",
+		MarkdownDescription: "Head of department.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"leader_type": schema.Int64Attribute{
+					Description:         "Person in charge type, 1 main and 2 deputy.",
+					MarkdownDescription: "Person in charge type, `1` main and `2` deputy.",
+					Computed:            true,
+				},
+				"leader_id": schema.StringAttribute{
+					Description:         "Person in charge ID.",
+					MarkdownDescription: "Person in charge ID.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func departmentLeadersToModel(leaders []common.DepartmentLeader) []Leaders {
+	result := []Leaders{}
+	for _, leader := range leaders {
+		result = append(result, Leaders{
+			LeaderType: types.Int64Value(leader.LeaderType),
+			LeaderID:   types.StringValue(leader.LeaderID),
+		})
+	}
+	return result
+}
@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/aganisatria/terraform-provider-lark/internal/common"
@@ -14,15 +16,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &departmentResource{}
+var _ resource.ResourceWithImportState = &departmentResource{}
 
 func NewDepartmentResource() resource.Resource {
 	return &departmentResource{}
@@ -55,6 +62,8 @@ type departmentResourceModel struct {
 	Leaders                []Leaders      `tfsdk:"leaders"`
 	GroupChatEmployeeTypes []types.Int64  `tfsdk:"group_chat_employee_types"`
 	MemberCount            types.Int64    `tfsdk:"member_count"`
+	DeletionPolicy         types.String   `tfsdk:"deletion_policy"`
+	DepartmentIDType       types.String   `tfsdk:"department_id_type"`
 }
 
 func (r *departmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -95,10 +104,20 @@ func (r *departmentResource) Schema(ctx context.Context, req resource.SchemaRequ
 			},
 		},
 		"parent_department_id": schema.StringAttribute{
-			Description:         "Parent department ID. If you want to create a root department, set it to 0.",
-			MarkdownDescription: "Parent department ID. If you want to create a root department, set it to 0.",
+			Description:         "Parent department ID, of the type set in department_id_type. If you want to create a root department, set it to 0.",
+			MarkdownDescription: "Parent department ID, of the type set in `department_id_type`. If you want to create a root department, set it to 0.",
 			Required:            true,
 		},
+		"department_id_type": schema.StringAttribute{
+			Description:         "Type of the ID in parent_department_id, either open_department_id or department_id for the custom department ID. Defaults to open_department_id.",
+			MarkdownDescription: "Type of the ID in `parent_department_id`, either `open_department_id` or `department_id` for the custom department ID. Defaults to `open_department_id`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(string(common.OPEN_DEPARTMENT_ID)),
+			Validators: []validator.String{
+				stringvalidator.OneOf(string(common.OPEN_DEPARTMENT_ID), string(common.DEPARTMENT_ID)),
+			},
+		},
 		"department_id": schema.StringAttribute{
 			Description:         "Department's custom department ID.",
 			MarkdownDescription: "Department's custom department ID.",
@@ -179,6 +198,16 @@ func (r *departmentResource) Schema(ctx context.Context, req resource.SchemaRequ
 			MarkdownDescription: "Number of users under the department.",
 			Computed:            true,
 		},
+		"deletion_policy": schema.StringAttribute{
+			Description:         "What happens on delete when the department still has users or child departments: fail lets the delete fail, move_members_to_parent moves them to the parent department first, and abandon only removes the department from the state. Defaults to fail.",
+			MarkdownDescription: "What happens on delete when the department still has users or child departments: `fail` lets the delete fail, `move_members_to_parent` moves them to the parent department first, and `abandon` only removes the department from the state. Defaults to `fail`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("fail"),
+			Validators: []validator.String{
+				stringvalidator.OneOf("fail", "move_members_to_parent", "abandon"),
+			},
+		},
 	}
 
 	for k, v := range baseAttributes {
@@ -262,13 +291,51 @@ func (r *departmentResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	parentDepartmentId := data.ParentDepartmentId.ValueString()
-	_, err := common.DepartmentGetByDepartmentIDAPI(ctx, r.client, parentDepartmentId)
-	if err != nil && parentDepartmentId != "0" {
-		resp.Diagnostics.AddError("API Error Reading Department", "Parent department not found")
+	// Departments imported by custom department ID have no open department ID yet.
+	var response *common.DepartmentGetResponse
+	var err error
+	if data.OpenDepartmentId.ValueString() == "" {
+		response, err = common.DepartmentGetByDepartmentIDAPI(ctx, r.client, data.DepartmentId.ValueString())
+	} else {
+		response, err = common.DepartmentGetByOpenDepartmentIDAPI(ctx, r.client, data.OpenDepartmentId.ValueString())
+	}
+	if common.IsAPIErrorCode(err, common.DEPARTMENT_NOT_FOUND_CODE) {
+		resp.Diagnostics.AddWarning(
+			"Department Deleted",
+			fmt.Sprintf("Department %s has been deleted outside of Terraform, removing it from the state.", data.DepartmentId.ValueString()),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	// Any other error keeps the department in the state, the request may only have failed.
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading Department", err.Error())
 		return
 	}
 
+	department := response.Data.Department
+	if department.Status.IsDeleted {
+		resp.Diagnostics.AddWarning(
+			"Department Deleted",
+			fmt.Sprintf("Department %s has been deleted outside of Terraform, removing it from the state.", department.OpenDepartmentID),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Lark returns the parent as an open department ID.
+	if departmentIDTypeOf(&data) == common.DEPARTMENT_ID && department.ParentDepartmentID != "0" {
+		parent, err := common.DepartmentGetByOpenDepartmentIDAPI(ctx, r.client, department.ParentDepartmentID)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Reading Parent Department", err.Error())
+			return
+		}
+		department.ParentDepartmentID = parent.Data.Department.DepartmentID
+	}
+
+	r.responseToModel(department, &data)
+	data.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.DEPARTMENT, department.DepartmentID))
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -288,6 +355,16 @@ func (r *departmentResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	// deletion_policy and department_id_type are never sent to Lark as they are, so changing only them,
+	// e.g. when their defaults are filled in for a state written before they existed, skips the update.
+	if onlyDepartmentProviderAttributesChanged(plan, state) {
+		state.DeletionPolicy = plan.DeletionPolicy
+		state.DepartmentIDType = plan.DepartmentIDType
+		state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
 	tempRequestBody, err := r.modelToRequest(ctx, &plan)
 	if err != nil {
 		resp.Diagnostics.AddError("API Error Updating Department", err.Error())
@@ -335,6 +412,16 @@ func (r *departmentResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	switch plan.DeletionPolicy.ValueString() {
+	case "abandon":
+		return
+	case "move_members_to_parent":
+		if errDiag := r.moveMembersToParent(ctx, plan.OpenDepartmentId.ValueString()); errDiag != nil {
+			resp.Diagnostics.AddError(errDiag.Summary(), errDiag.Detail())
+			return
+		}
+	}
+
 	_, err := common.DepartmentDeleteAPI(ctx, r.client, plan.OpenDepartmentId.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("API Error Deleting Department", err.Error())
@@ -342,6 +429,32 @@ func (r *departmentResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 }
 
+// ImportState takes either an open department ID, or the ID type and the ID separated by a slash, e.g. department_id/D001.
+// The ID type is imported as department_id_type, so parent_department_id is read with the same type.
+func (r *departmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	departmentIDType, departmentID, found := strings.Cut(req.ID, "/")
+	if !found {
+		departmentIDType, departmentID = string(common.OPEN_DEPARTMENT_ID), req.ID
+	}
+
+	switch common.DepartmentIDType(departmentIDType) {
+	case common.OPEN_DEPARTMENT_ID:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("open_department_id"), departmentID)...)
+	case common.DEPARTMENT_ID:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("department_id"), departmentID)...)
+	default:
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an open department ID or <department_id_type>/<id>, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("department_id_type"), departmentIDType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_policy"), "fail")...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("create_group_chat"), false)...)
+}
+
 func (r *departmentResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
 	if r.client == nil {
 		return []resource.ConfigValidator{}
@@ -353,9 +466,14 @@ func (r *departmentResource) ConfigValidators(ctx context.Context) []resource.Co
 
 func (r *departmentResource) modelToRequest(ctx context.Context, data *departmentResourceModel) (common.BaseDepartment, error) {
 
-	_, err := common.DepartmentGetByDepartmentIDAPI(ctx, r.client, data.ParentDepartmentId.ValueString())
-	if err != nil && data.ParentDepartmentId.ValueString() != "0" {
-		return common.BaseDepartment{}, err
+	// The department APIs take the parent as an open department ID.
+	parentDepartmentID := data.ParentDepartmentId.ValueString()
+	if parentDepartmentID != "0" {
+		parent, err := common.DepartmentGetAPI(ctx, r.client, parentDepartmentID, departmentIDTypeOf(data))
+		if err != nil {
+			return common.BaseDepartment{}, err
+		}
+		parentDepartmentID = parent.Data.Department.OpenDepartmentID
 	}
 
 	// Special case handle null, need use pointer to avoid nil pointer dereference
@@ -384,7 +502,7 @@ func (r *departmentResource) modelToRequest(ctx context.Context, data *departmen
 	return common.BaseDepartment{
 		Name:                   data.Name.ValueString(),
 		I18nName:               i18nName,
-		ParentDepartmentID:     data.ParentDepartmentId.ValueString(),
+		ParentDepartmentID:     parentDepartmentID,
 		LeaderUserID:           data.LeaderUserID.ValueString(),
 		Order:                  data.Order.ValueString(),
 		UnitIDs:                common.StringValuesToStrings(data.UnitIDs),
@@ -394,6 +512,43 @@ func (r *departmentResource) modelToRequest(ctx context.Context, data *departmen
 	}, nil
 }
 
+// responseToModel refreshes the model from the department in Lark.
+// order, unit_ids, leaders and group_chat_employee_types are left unset when they are not configured,
+// since Lark fills them in by itself, e.g. leaders from leader_user_id.
+func (r *departmentResource) responseToModel(department common.Department, data *departmentResourceModel) {
+	data.Name = types.StringValue(department.Name)
+	data.ParentDepartmentId = types.StringValue(department.ParentDepartmentID)
+	data.DepartmentId = types.StringValue(department.DepartmentID)
+	data.OpenDepartmentId = types.StringValue(department.OpenDepartmentID)
+	data.ChatID = types.StringValue(department.ChatID)
+	data.MemberCount = types.Int64Value(int64(department.MemberCount))
+
+	if data.I18nName != nil {
+		data.I18nName = &I18nName{
+			ZhCn: optionalStringValue(data.I18nName.ZhCn, department.I18nName.ZhCn),
+			JaJp: optionalStringValue(data.I18nName.JaJp, department.I18nName.JaJp),
+			EnUs: optionalStringValue(data.I18nName.EnUs, department.I18nName.EnUs),
+		}
+	}
+	data.LeaderUserID = optionalStringValue(data.LeaderUserID, department.LeaderUserID)
+	if data.Leaders != nil {
+		data.Leaders = departmentLeadersToModel(department.Leaders)
+	}
+	if data.UnitIDs != nil {
+		data.UnitIDs = common.StringsToStringValues(department.UnitIDs)
+	}
+
+	if !data.Order.IsNull() {
+		data.Order = types.StringValue(department.Order)
+	}
+	if data.GroupChatEmployeeTypes != nil {
+		data.GroupChatEmployeeTypes = []types.Int64{}
+		for _, employeeType := range department.GroupChatEmployeeTypes {
+			data.GroupChatEmployeeTypes = append(data.GroupChatEmployeeTypes, types.Int64Value(employeeType))
+		}
+	}
+}
+
 // We use modify plan when we need both plan and state when validating.
 func (r *departmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	var plan, state *departmentResourceModel
@@ -402,6 +557,7 @@ func (r *departmentResource) ModifyPlan(ctx context.Context, req resource.Modify
 
 	// plan null means resource is being deleted.
 	if req.Plan.Raw.IsNull() {
+		r.warnDeletion(ctx, state, resp)
 		return
 	}
 
@@ -429,3 +585,145 @@ func (r *departmentResource) ModifyPlan(ctx context.Context, req resource.Modify
 		}
 	}
 }
+
+// warnDeletion tells how many users and child departments are affected by deleting the department.
+func (r *departmentResource) warnDeletion(ctx context.Context, state *departmentResourceModel, resp *resource.ModifyPlanResponse) {
+	openDepartmentID := state.OpenDepartmentId.ValueString()
+	children, err := common.DepartmentChildrenListAPI(ctx, r.client, openDepartmentID, common.OPEN_DEPARTMENT_ID, false)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable To Count Department Members", err.Error())
+		return
+	}
+	users, err := common.UserListByDepartmentAPI(ctx, r.client, openDepartmentID, common.OPEN_DEPARTMENT_ID, common.OPEN_ID)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable To Count Department Members", err.Error())
+		return
+	}
+
+	name := state.Name.ValueString()
+	userCount := len(users.Data.Items)
+	departmentCount := len(children.Data.Items)
+	switch state.DeletionPolicy.ValueString() {
+	case "abandon":
+		resp.Diagnostics.AddWarning(
+			"Department Will Be Abandoned",
+			fmt.Sprintf("Department %s is only removed from the state, it is left in Lark with %d users and %d departments.", name, userCount, departmentCount),
+		)
+	case "move_members_to_parent":
+		if userCount > 0 || departmentCount > 0 {
+			resp.Diagnostics.AddWarning(
+				"Department Members Will Be Moved",
+				fmt.Sprintf("Deleting department %s moves %d users and %d departments to its parent department.", name, userCount, departmentCount),
+			)
+		}
+	default:
+		if userCount > 0 || departmentCount > 0 {
+			resp.Diagnostics.AddWarning(
+				"Department Is Not Empty",
+				fmt.Sprintf("Department %s still has %d users and %d departments, deleting it will fail. Set deletion_policy to move_members_to_parent or abandon to delete it anyway.", name, userCount, departmentCount),
+			)
+		}
+	}
+}
+
+// moveMembersToParent moves the child departments and the users of the department to its parent department.
+func (r *departmentResource) moveMembersToParent(ctx context.Context, openDepartmentID string) *diag.ErrorDiagnostic {
+	department, err := common.DepartmentGetAPI(ctx, r.client, openDepartmentID, common.OPEN_DEPARTMENT_ID)
+	if err != nil {
+		errDiag := diag.NewErrorDiagnostic("API Error Reading Department", err.Error())
+		return &errDiag
+	}
+	parentID := department.Data.Department.ParentDepartmentID
+
+	children, err := common.DepartmentChildrenListAPI(ctx, r.client, openDepartmentID, common.OPEN_DEPARTMENT_ID, false)
+	if err != nil {
+		errDiag := diag.NewErrorDiagnostic("API Error Listing Department Children", err.Error())
+		return &errDiag
+	}
+	for _, child := range children.Data.Items {
+		// The update replaces the whole department, so it starts from the child itself.
+		request := child.BaseDepartment
+		request.ParentDepartmentID = parentID
+		request.CreateGroupChat = false
+		_, err := common.DepartmentUpdateAPI(ctx, r.client, child.OpenDepartmentID, common.DepartmentUpdateRequest{
+			BaseDepartment: request,
+		})
+		if err != nil {
+			errDiag := diag.NewErrorDiagnostic("API Error Updating Department", err.Error())
+			return &errDiag
+		}
+	}
+
+	users, err := common.UserListByDepartmentAPI(ctx, r.client, openDepartmentID, common.OPEN_DEPARTMENT_ID, common.OPEN_ID)
+	if err != nil {
+		errDiag := diag.NewErrorDiagnostic("API Error Listing Department Users", err.Error())
+		return &errDiag
+	}
+	for _, user := range users.Data.Items {
+		departmentIDs := slices.DeleteFunc(slices.Clone(user.DepartmentIDs), func(departmentID string) bool {
+			return departmentID == openDepartmentID
+		})
+		if !slices.Contains(departmentIDs, parentID) {
+			departmentIDs = append(departmentIDs, parentID)
+		}
+		_, err := common.UserUpdateDepartmentsAPI(ctx, r.client, user.OpenID, departmentIDs)
+		if err != nil {
+			errDiag := diag.NewErrorDiagnostic("API Error Updating User Departments", err.Error())
+			return &errDiag
+		}
+	}
+
+	return nil
+}
+
+// onlyDepartmentProviderAttributesChanged reports whether the plan only changes attributes that are used by
+// the provider itself. A changed department_id_type counts when it changes the meaning of parent_department_id.
+func onlyDepartmentProviderAttributesChanged(plan departmentResourceModel, state departmentResourceModel) bool {
+	if departmentIDTypeOf(&plan) != departmentIDTypeOf(&state) {
+		return false
+	}
+
+	// department_id is unknown in the plan of an update when it's not configured.
+	if !plan.DepartmentId.IsUnknown() && !plan.DepartmentId.Equal(state.DepartmentId) {
+		return false
+	}
+
+	return plan.Name.Equal(state.Name) &&
+		sameI18nName(plan.I18nName, state.I18nName) &&
+		plan.ParentDepartmentId.Equal(state.ParentDepartmentId) &&
+		plan.LeaderUserID.Equal(state.LeaderUserID) &&
+		plan.Order.Equal(state.Order) &&
+		sameValues(plan.UnitIDs, state.UnitIDs) &&
+		plan.CreateGroupChat.Equal(state.CreateGroupChat) &&
+		sameDepartmentLeaders(plan.Leaders, state.Leaders) &&
+		sameValues(plan.GroupChatEmployeeTypes, state.GroupChatEmployeeTypes)
+}
+
+func sameI18nName(a *I18nName, b *I18nName) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.ZhCn.Equal(b.ZhCn) && a.JaJp.Equal(b.JaJp) && a.EnUs.Equal(b.EnUs)
+}
+
+func sameDepartmentLeaders(a []Leaders, b []Leaders) bool {
+	return (a == nil) == (b == nil) && slices.EqualFunc(a, b, func(x, y Leaders) bool {
+		return x.LeaderType.Equal(y.LeaderType) && x.LeaderID.Equal(y.LeaderID)
+	})
+}
+
+// sameValues compares two lists, a null list is not the same as an empty one.
+func sameValues[T attr.Value](a []T, b []T) bool {
+	return (a == nil) == (b == nil) && slices.EqualFunc(a, b, func(x, y T) bool {
+		return x.Equal(y)
+	})
+}
+
+// departmentIDTypeOf returns the type of the parent department ID, states written before
+// department_id_type existed use open department IDs.
+func departmentIDTypeOf(data *departmentResourceModel) common.DepartmentIDType {
+	if data.DepartmentIDType.ValueString() == string(common.DEPARTMENT_ID) {
+		return common.DEPARTMENT_ID
+	}
+	return common.OPEN_DEPARTMENT_ID
+}
@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DepartmentUsersDataSource{}
+
+func NewDepartmentUsersDataSource() datasource.DataSource {
+	return &DepartmentUsersDataSource{}
+}
+
+// DepartmentUsersDataSource defines the data source implementation.
+type DepartmentUsersDataSource struct {
+	client *common.LarkClient
+}
+
+type DepartmentUser struct {
+	OpenID  types.String `tfsdk:"open_id"`
+	UserID  types.String `tfsdk:"user_id"`
+	UnionID types.String `tfsdk:"union_id"`
+	Name    types.String `tfsdk:"name"`
+	Email   types.String `tfsdk:"email"`
+}
+
+// DepartmentUsersDataSourceModel describes the data source data model.
+type DepartmentUsersDataSourceModel struct {
+	BaseResourceModel
+	DepartmentID  types.String     `tfsdk:"department_id"`
+	Recursive     types.Bool       `tfsdk:"recursive"`
+	Status        types.String     `tfsdk:"status"`
+	EmployeeTypes []types.Int64    `tfsdk:"employee_types"`
+	Users         []DepartmentUser `tfsdk:"users"`
+}
+
+func (d *DepartmentUsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_department_users"
+}
+
+func (d *DepartmentUsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"department_id": schema.StringAttribute{
+			Description:         "Open department ID of the department, 0 for the root department.",
+			MarkdownDescription: "Open department ID of the department, `0` for the root department.",
+			Required:            true,
+		},
+		"recursive": schema.BoolAttribute{
+			Description:         "Whether the users of every descendant department are returned as well. Defaults to false.",
+			MarkdownDescription: "Whether the users of every descendant department are returned as well. Defaults to `false`.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			Description:         "Only return the users with this status, one of active, resigned and frozen. All users are returned when not set.",
+			MarkdownDescription: "Only return the users with this status, one of `active`, `resigned` and `frozen`. All users are returned when not set.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("active", "resigned", "frozen"),
+			},
+		},
+		"employee_types": schema.ListAttribute{
+			Description:         "Only return the users with one of these employee types, 1 regular, 2 intern, 3 outsourcing, 4 labor and 5 consultant. All employee types are returned when empty.",
+			MarkdownDescription: "Only return the users with one of these employee types, `1` regular, `2` intern, `3` outsourcing, `4` labor and `5` consultant. All employee types are returned when empty.",
+			Optional:            true,
+			ElementType:         types.Int64Type,
+			Validators: []validator.List{
+				listvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+			},
+		},
+		"users": schema.ListNestedAttribute{
+			Description:         "Users of the department, each user only once.",
+			MarkdownDescription: "Users of the department, each user only once.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"open_id": schema.StringAttribute{
+						Description:         "Open ID of the user.",
+						MarkdownDescription: "Open ID of the user.",
+						Computed:            true,
+					},
+					"user_id": schema.StringAttribute{
+						Description:         "User ID of the user.",
+						MarkdownDescription: "User ID of the user.",
+						Computed:            true,
+					},
+					"union_id": schema.StringAttribute{
+						Description:         "Union ID of the user.",
+						MarkdownDescription: "Union ID of the user.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						Description:         "Name of the user.",
+						MarkdownDescription: "Name of the user.",
+						Computed:            true,
+					},
+					"email": schema.StringAttribute{
+						Description:         "Email of the user.",
+						MarkdownDescription: "Email of the user.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Retrieve the users of a department in Lark",
+		MarkdownDescription: "Retrieve the users of a department in Lark",
+		Attributes:          attributes,
+	}
+}
+
+func (d *DepartmentUsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DepartmentUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DepartmentUsersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Recursive.IsNull() {
+		data.Recursive = types.BoolValue(false)
+	}
+
+	users, errDiag := listDepartmentUsers(ctx, d.client, []string{data.DepartmentID.ValueString()}, data.Recursive.ValueBool())
+	if errDiag != nil {
+		resp.Diagnostics.AddError(errDiag.Summary(), errDiag.Detail())
+		return
+	}
+
+	employeeTypes := []int{}
+	for _, employeeType := range data.EmployeeTypes {
+		employeeTypes = append(employeeTypes, int(employeeType.ValueInt64()))
+	}
+
+	data.Users = []DepartmentUser{}
+	for _, user := range users {
+		if !data.Status.IsNull() && departmentUserStatus(user.Status) != data.Status.ValueString() {
+			continue
+		}
+		if len(employeeTypes) > 0 && !slices.Contains(employeeTypes, user.EmployeeType) {
+			continue
+		}
+
+		data.Users = append(data.Users, DepartmentUser{
+			OpenID:  types.StringValue(user.OpenID),
+			UserID:  types.StringValue(user.UserID),
+			UnionID: types.StringValue(user.UnionID),
+			Name:    types.StringValue(user.Name),
+			Email:   types.StringValue(user.Email),
+		})
+	}
+
+	data.Id = types.StringValue(common.ConstructID(common.DATA_SOURCE, common.DEPARTMENT_USERS, data.DepartmentID.ValueString()))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// departmentUserStatus sums up the status flags of a user, resigned takes precedence over frozen.
+func departmentUserStatus(status common.UserStatus) string {
+	if status.IsResigned {
+		return "resigned"
+	}
+	if status.IsFrozen {
+		return "frozen"
+	}
+	return "active"
+}
+
+// listDepartmentUsers lists the users directly under the open departments, and under
+// every descendant department when recursive is set. Each user is returned only once.
+func listDepartmentUsers(ctx context.Context, client *common.LarkClient, departmentIDs []string, recursive bool) ([]common.User, *diag.ErrorDiagnostic) {
+	departments := []string{}
+	for _, id := range departmentIDs {
+		if !slices.Contains(departments, id) {
+			departments = append(departments, id)
+		}
+	}
+
+	if recursive {
+		for _, id := range slices.Clone(departments) {
+			children, err := common.DepartmentChildrenListAPI(ctx, client, id, common.OPEN_DEPARTMENT_ID, true)
+			if err != nil {
+				errDiag := diag.NewErrorDiagnostic("API Error Listing Department Children", err.Error())
+				return nil, &errDiag
+			}
+			for _, child := range children.Data.Items {
+				if !slices.Contains(departments, child.OpenDepartmentID) {
+					departments = append(departments, child.OpenDepartmentID)
+				}
+			}
+		}
+	}
+
+	users := []common.User{}
+	seen := map[string]bool{}
+	for _, id := range departments {
+		response, err := common.UserListByDepartmentAPI(ctx, client, id, common.OPEN_DEPARTMENT_ID, common.OPEN_ID)
+		if err != nil {
+			errDiag := diag.NewErrorDiagnostic("API Error Listing Department Users", err.Error())
+			return nil, &errDiag
+		}
+		for _, user := range response.Data.Items {
+			if seen[user.OpenID] {
+				continue
+			}
+			seen[user.OpenID] = true
+			users = append(users, user)
+		}
+	}
+
+	return users, nil
+}
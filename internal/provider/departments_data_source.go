@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DepartmentsDataSource{}
+
+func NewDepartmentsDataSource() datasource.DataSource {
+	return &DepartmentsDataSource{}
+}
+
+// DepartmentsDataSource defines the data source implementation.
+type DepartmentsDataSource struct {
+	client *common.LarkClient
+}
+
+type DepartmentSummary struct {
+	DepartmentID       types.String `tfsdk:"department_id"`
+	OpenDepartmentID   types.String `tfsdk:"open_department_id"`
+	Name               types.String `tfsdk:"name"`
+	ParentDepartmentID types.String `tfsdk:"parent_department_id"`
+	LeaderUserID       types.String `tfsdk:"leader_user_id"`
+	Leaders            []Leaders    `tfsdk:"leaders"`
+	Order              types.String `tfsdk:"order"`
+	ChatID             types.String `tfsdk:"chat_id"`
+	MemberCount        types.Int64  `tfsdk:"member_count"`
+}
+
+// DepartmentsDataSourceModel describes the data source data model.
+type DepartmentsDataSourceModel struct {
+	BaseResourceModel
+	ParentDepartmentID types.String        `tfsdk:"parent_department_id"`
+	Recursive          types.Bool          `tfsdk:"recursive"`
+	Departments        []DepartmentSummary `tfsdk:"departments"`
+}
+
+func (d *DepartmentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_departments"
+}
+
+func (d *DepartmentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"parent_department_id": schema.StringAttribute{
+			Description:         "Open department ID of the department whose children are returned, 0 for the root department.",
+			MarkdownDescription: "Open department ID of the department whose children are returned, `0` for the root department.",
+			Required:            true,
+		},
+		"recursive": schema.BoolAttribute{
+			Description:         "Whether every descendant is returned instead of the direct children only. Defaults to false.",
+			MarkdownDescription: "Whether every descendant is returned instead of the direct children only. Defaults to `false`.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"departments": schema.ListNestedAttribute{
+			Description:         "Child departments, each with the open department ID of its parent.",
+			MarkdownDescription: "Child departments, each with the open department ID of its parent.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"department_id": schema.StringAttribute{
+						Description:         "Department's custom department ID.",
+						MarkdownDescription: "Department's custom department ID.",
+						Computed:            true,
+					},
+					"open_department_id": schema.StringAttribute{
+						Description:         "Department's open department ID.",
+						MarkdownDescription: "Department's open department ID.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						Description:         "Department name.",
+						MarkdownDescription: "Department name.",
+						Computed:            true,
+					},
+					"parent_department_id": schema.StringAttribute{
+						Description:         "Open department ID of the parent department.",
+						MarkdownDescription: "Open department ID of the parent department.",
+						Computed:            true,
+					},
+					"leader_user_id": schema.StringAttribute{
+						Description:         "Department manager's user ID.",
+						MarkdownDescription: "Department manager's user ID.",
+						Computed:            true,
+					},
+					"leaders": departmentLeadersDataSourceAttribute(),
+					"order": schema.StringAttribute{
+						Description:         "Department order among the departments at the same level.",
+						MarkdownDescription: "Department order among the departments at the same level.",
+						Computed:            true,
+					},
+					"chat_id": schema.StringAttribute{
+						Description:         "Department group chat ID.",
+						MarkdownDescription: "Department group chat ID.",
+						Computed:            true,
+					},
+					"member_count": schema.Int64Attribute{
+						Description:         "Number of users under the department.",
+						MarkdownDescription: "Number of users under the department.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Retrieve the child departments of a department in Lark",
+		MarkdownDescription: "Retrieve the child departments of a department in Lark",
+		Attributes:          attributes,
+	}
+}
+
+func (d *DepartmentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DepartmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DepartmentsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Recursive.IsNull() {
+		data.Recursive = types.BoolValue(false)
+	}
+
+	response, err := common.DepartmentChildrenListAPI(ctx, d.client, data.ParentDepartmentID.ValueString(), common.OPEN_DEPARTMENT_ID, data.Recursive.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Listing Department Children", err.Error())
+		return
+	}
+
+	data.Departments = []DepartmentSummary{}
+	for _, department := range response.Data.Items {
+		data.Departments = append(data.Departments, DepartmentSummary{
+			DepartmentID:       types.StringValue(department.DepartmentID),
+			OpenDepartmentID:   types.StringValue(department.OpenDepartmentID),
+			Name:               types.StringValue(department.Name),
+			ParentDepartmentID: types.StringValue(department.ParentDepartmentID),
+			LeaderUserID:       types.StringValue(department.LeaderUserID),
+			Leaders:            departmentLeadersToModel(department.Leaders),
+			Order:              types.StringValue(department.Order),
+			ChatID:             types.StringValue(department.ChatID),
+			MemberCount:        types.Int64Value(int64(department.MemberCount)),
+		})
+	}
+
+	data.Id = types.StringValue(common.ConstructID(common.DATA_SOURCE, common.DEPARTMENTS, data.ParentDepartmentID.ValueString()))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,464 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &groupChatAnnouncementResource{}
+var _ resource.ResourceWithImportState = &groupChatAnnouncementResource{}
+
+const (
+	announcementFormatPlainText = "plain_text"
+	announcementFormatMarkdown  = "markdown"
+	// The announcement body lives in zone 0 of the document.
+	announcementBodyZoneID = "0"
+)
+
+var (
+	announcementHeadingRegex     = regexp.MustCompile(`^(#{1,6})\s+(.*)$`)
+	announcementBulletListRegex  = regexp.MustCompile(`^(\s*)[-*+]\s+(.*)$`)
+	announcementNumberListRegex  = regexp.MustCompile(`^(\s*)(\d+)[.)]\s+(.*)$`)
+	announcementBoldTextRunRegex = regexp.MustCompile(`\*\*(.+?)\*\*`)
+)
+
+func NewGroupChatAnnouncementResource() resource.Resource {
+	return &groupChatAnnouncementResource{}
+}
+
+// groupChatAnnouncementResource defines the resource implementation.
+type groupChatAnnouncementResource struct {
+	client *common.LarkClient
+}
+
+// groupChatAnnouncementResourceModel describes the resource data model.
+// fields that need to be configured by user.
+type groupChatAnnouncementResourceModel struct {
+	BaseResourceModel
+	GroupChatID   types.String `tfsdk:"group_chat_id"`
+	Content       types.String `tfsdk:"content"`
+	ContentFormat types.String `tfsdk:"content_format"`
+	Revision      types.String `tfsdk:"revision"`
+}
+
+func (r *groupChatAnnouncementResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_chat_announcement"
+}
+
+func (r *groupChatAnnouncementResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"group_chat_id": schema.StringAttribute{
+			Description:         "Unique identity of the group chat, unique under a single tenant",
+			MarkdownDescription: "Unique identity of the group chat, unique under a single tenant",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"content": schema.StringAttribute{
+			Description:         "Announcement content. Every line becomes a paragraph of the announcement.",
+			MarkdownDescription: "Announcement content. Every line becomes a paragraph of the announcement.",
+			Required:            true,
+		},
+		"content_format": schema.StringAttribute{
+			Description:         "Format of the content. plain_text writes the content as is, markdown renders headings, bullet lists, numbered lists and bold text.",
+			MarkdownDescription: "Format of the content. `plain_text` writes the content as is, `markdown` renders headings, bullet lists, numbered lists and bold text.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(announcementFormatPlainText),
+			Validators: []validator.String{
+				stringvalidator.OneOf(announcementFormatPlainText, announcementFormatMarkdown),
+			},
+		},
+		"revision": schema.StringAttribute{
+			Description:         "Revision of the announcement written by terraform. A different revision in Lark means the announcement was edited outside of terraform.",
+			MarkdownDescription: "Revision of the announcement written by terraform. A different revision in Lark means the announcement was edited outside of terraform.",
+			Computed:            true,
+		},
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Manages the announcement of a group chat in Lark. Destroying this resource clears the announcement.",
+		MarkdownDescription: "Manages the announcement of a group chat in Lark. Destroying this resource clears the announcement.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *groupChatAnnouncementResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *groupChatAnnouncementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data groupChatAnnouncementResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := common.GroupChatAnnouncementGetAPI(ctx, r.client, data.GroupChatID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading Group Chat Announcement", err.Error())
+		return
+	}
+
+	revision, errDiag := r.WriteHelper(ctx, data.GroupChatID.ValueString(), current, renderAnnouncementBlocks(data.Content.ValueString(), data.ContentFormat.ValueString()))
+	if errDiag != nil {
+		resp.Diagnostics.AddError(errDiag.Summary(), errDiag.Detail())
+		return
+	}
+
+	data.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.GROUP_CHAT_ANNOUNCEMENT, data.GroupChatID.ValueString()))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	data.Revision = types.StringValue(revision)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *groupChatAnnouncementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state groupChatAnnouncementResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := common.GroupChatAnnouncementGetAPI(ctx, r.client, state.GroupChatID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading Group Chat Announcement", err.Error())
+		return
+	}
+
+	// The announcement was edited outside of terraform, so the content in the state is replaced with the text in Lark.
+	// The plan then shows the difference, and applying it writes the configured content back.
+	if state.Revision.ValueString() != response.Data.Revision {
+		document, err := parseAnnouncementDocument(response.Data.Content)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Parsing Group Chat Announcement", err.Error())
+			return
+		}
+
+		state.Content = types.StringValue(announcementText(document))
+		state.Revision = types.StringValue(response.Data.Revision)
+	}
+
+	if state.ContentFormat.IsNull() {
+		state.ContentFormat = types.StringValue(announcementFormatPlainText)
+	}
+	if state.Id.IsNull() || state.Id.ValueString() == "" {
+		state.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.GROUP_CHAT_ANNOUNCEMENT, state.GroupChatID.ValueString()))
+	}
+	state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *groupChatAnnouncementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan groupChatAnnouncementResourceModel
+	var state groupChatAnnouncementResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := common.GroupChatAnnouncementGetAPI(ctx, r.client, state.GroupChatID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading Group Chat Announcement", err.Error())
+		return
+	}
+
+	// Someone edited the announcement after terraform planned the change, don't overwrite it without showing it first.
+	if current.Data.Revision != state.Revision.ValueString() {
+		resp.Diagnostics.AddError(
+			"Group Chat Announcement Conflict",
+			fmt.Sprintf(
+				"The announcement of group chat %s was edited outside of terraform (revision %s, expected %s). Run terraform plan again to review the changes before overwriting them.",
+				state.GroupChatID.ValueString(),
+				current.Data.Revision,
+				state.Revision.ValueString(),
+			),
+		)
+		return
+	}
+
+	revision, errDiag := r.WriteHelper(ctx, state.GroupChatID.ValueString(), current, renderAnnouncementBlocks(plan.Content.ValueString(), plan.ContentFormat.ValueString()))
+	if errDiag != nil {
+		resp.Diagnostics.AddError(errDiag.Summary(), errDiag.Detail())
+		return
+	}
+
+	plan.Id = types.StringValue(state.Id.ValueString())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	plan.Revision = types.StringValue(revision)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *groupChatAnnouncementResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state groupChatAnnouncementResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := common.GroupChatAnnouncementGetAPI(ctx, r.client, state.GroupChatID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading Group Chat Announcement", err.Error())
+		return
+	}
+
+	_, errDiag := r.WriteHelper(ctx, state.GroupChatID.ValueString(), current, nil)
+	if errDiag != nil {
+		resp.Diagnostics.AddError(errDiag.Summary(), errDiag.Detail())
+		return
+	}
+}
+
+// ImportState imports the announcement using the group chat ID.
+func (r *groupChatAnnouncementResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("group_chat_id"), req, resp)
+}
+
+// WriteHelper replaces the whole announcement with the given blocks, and returns the new revision.
+func (r *groupChatAnnouncementResource) WriteHelper(ctx context.Context, groupChatID string, current *common.GroupChatAnnouncementGetResponse, blocks []common.AnnouncementBlock) (string, *diag.ErrorDiagnostic) {
+	document, err := parseAnnouncementDocument(current.Data.Content)
+	if err != nil {
+		errDiag := diag.NewErrorDiagnostic("Error Parsing Group Chat Announcement", err.Error())
+		return "", &errDiag
+	}
+
+	requests, err := buildAnnouncementRequests(document, blocks)
+	if err != nil {
+		errDiag := diag.NewErrorDiagnostic("Error Building Group Chat Announcement", err.Error())
+		return "", &errDiag
+	}
+
+	if len(requests) > 0 {
+		_, err = common.GroupChatAnnouncementUpdateAPI(ctx, r.client, groupChatID, common.GroupChatAnnouncementUpdateRequest{
+			Revision: current.Data.Revision,
+			Requests: requests,
+		})
+		if err != nil {
+			errDiag := diag.NewErrorDiagnostic("API Error Updating Group Chat Announcement", err.Error())
+			return "", &errDiag
+		}
+	}
+
+	// The update API doesn't return the new revision.
+	updated, err := common.GroupChatAnnouncementGetAPI(ctx, r.client, groupChatID)
+	if err != nil {
+		errDiag := diag.NewErrorDiagnostic("API Error Reading Group Chat Announcement", err.Error())
+		return "", &errDiag
+	}
+
+	return updated.Data.Revision, nil
+}
+
+// parseAnnouncementDocument parses the announcement content returned by Lark, an empty announcement has no content.
+func parseAnnouncementDocument(content string) (common.AnnouncementDocument, error) {
+	document := common.AnnouncementDocument{}
+	if content == "" {
+		return document, nil
+	}
+
+	if err := json.Unmarshal([]byte(content), &document); err != nil {
+		return document, fmt.Errorf("error unmarshaling announcement content: %w", err)
+	}
+
+	return document, nil
+}
+
+// announcementText returns the text of the announcement, one line per paragraph.
+func announcementText(document common.AnnouncementDocument) string {
+	lines := []string{}
+	for _, block := range document.Body.Blocks {
+		if block.Paragraph == nil {
+			continue
+		}
+
+		var line strings.Builder
+		for _, element := range block.Paragraph.Elements {
+			if element.TextRun != nil {
+				line.WriteString(element.TextRun.Text)
+			}
+		}
+		lines = append(lines, line.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// buildAnnouncementRequests builds the requests that delete the current body and insert the new blocks.
+// Every paragraph takes the length of its text plus its line break, and every other block takes 1.
+// The last line break of the body can't be deleted.
+func buildAnnouncementRequests(document common.AnnouncementDocument, blocks []common.AnnouncementBlock) ([]string, error) {
+	bodyLength := 0
+	for _, block := range document.Body.Blocks {
+		if block.Paragraph == nil {
+			bodyLength++
+			continue
+		}
+
+		for _, element := range block.Paragraph.Elements {
+			if element.TextRun != nil {
+				bodyLength += utf8.RuneCountInString(element.TextRun.Text)
+			}
+		}
+		bodyLength++
+	}
+
+	changes := []common.AnnouncementChangeRequest{}
+	if bodyLength > 1 {
+		changes = append(changes, common.AnnouncementChangeRequest{
+			RequestType: "DeleteContentRangeRequestType",
+			DeleteContentRangeRequest: &common.AnnouncementDeleteContentRangeRequest{
+				DeleteRange: common.AnnouncementRange{
+					ZoneID:     announcementBodyZoneID,
+					StartIndex: 1,
+					EndIndex:   bodyLength,
+				},
+			},
+		})
+	}
+
+	if len(blocks) > 0 {
+		payload, err := json.Marshal(common.AnnouncementBody{Blocks: blocks})
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling announcement blocks: %w", err)
+		}
+
+		changes = append(changes, common.AnnouncementChangeRequest{
+			RequestType: "InsertBlocksRequestType",
+			InsertBlocksRequest: &common.AnnouncementInsertBlocksRequest{
+				Payload: string(payload),
+				Location: common.AnnouncementLocation{
+					ZoneID:    announcementBodyZoneID,
+					Index:     0,
+					EndOfZone: true,
+				},
+			},
+		})
+	}
+
+	requests := []string{}
+	for _, change := range changes {
+		request, err := json.Marshal(change)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling announcement request: %w", err)
+		}
+		requests = append(requests, string(request))
+	}
+
+	return requests, nil
+}
+
+// renderAnnouncementBlocks renders every line of the content into a paragraph block.
+func renderAnnouncementBlocks(content string, format string) []common.AnnouncementBlock {
+	blocks := []common.AnnouncementBlock{}
+	for _, line := range strings.Split(content, "\n") {
+		paragraph := &common.AnnouncementParagraph{}
+
+		if format != announcementFormatMarkdown {
+			paragraph.Elements = []common.AnnouncementElement{newAnnouncementTextRun(line, false)}
+			blocks = append(blocks, common.AnnouncementBlock{Type: "paragraph", Paragraph: paragraph})
+			continue
+		}
+
+		// Blank lines only separate paragraphs in markdown.
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		text := line
+		if match := announcementHeadingRegex.FindStringSubmatch(line); match != nil {
+			paragraph.Style.HeadingLevel = len(match[1])
+			text = match[2]
+		} else if match := announcementBulletListRegex.FindStringSubmatch(line); match != nil {
+			paragraph.Style.List = &common.AnnouncementList{Type: "bullet", IndentLevel: len(match[1])/2 + 1}
+			text = match[2]
+		} else if match := announcementNumberListRegex.FindStringSubmatch(line); match != nil {
+			number, _ := strconv.Atoi(match[2])
+			paragraph.Style.List = &common.AnnouncementList{Type: "number", IndentLevel: len(match[1])/2 + 1, Number: number}
+			text = match[3]
+		}
+
+		paragraph.Elements = renderAnnouncementTextRuns(text)
+		blocks = append(blocks, common.AnnouncementBlock{Type: "paragraph", Paragraph: paragraph})
+	}
+
+	return blocks
+}
+
+// renderAnnouncementTextRuns splits the text into text runs, making the text between ** bold.
+func renderAnnouncementTextRuns(text string) []common.AnnouncementElement {
+	elements := []common.AnnouncementElement{}
+	last := 0
+	for _, match := range announcementBoldTextRunRegex.FindAllStringSubmatchIndex(text, -1) {
+		if match[0] > last {
+			elements = append(elements, newAnnouncementTextRun(text[last:match[0]], false))
+		}
+		elements = append(elements, newAnnouncementTextRun(text[match[2]:match[3]], true))
+		last = match[1]
+	}
+
+	if last < len(text) || len(elements) == 0 {
+		elements = append(elements, newAnnouncementTextRun(text[last:], false))
+	}
+
+	return elements
+}
+
+func newAnnouncementTextRun(text string, bold bool) common.AnnouncementElement {
+	return common.AnnouncementElement{
+		Type: "textRun",
+		TextRun: &common.AnnouncementTextRun{
+			Text:  text,
+			Style: common.AnnouncementTextStyle{Bold: bold},
+		},
+	}
+}
@@ -0,0 +1,332 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &GroupChatDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &GroupChatDataSource{}
+
+func NewGroupChatDataSource() datasource.DataSource {
+	return &GroupChatDataSource{}
+}
+
+// GroupChatDataSource defines the data source implementation.
+type GroupChatDataSource struct {
+	client *common.LarkClient
+}
+
+// GroupChatDataSourceModel describes the data source data model.
+type GroupChatDataSourceModel struct {
+	BaseResourceModel
+	ChatID                 types.String           `tfsdk:"chat_id"`
+	Avatar                 types.String           `tfsdk:"avatar"`
+	Name                   types.String           `tfsdk:"name"`
+	Description            types.String           `tfsdk:"description"`
+	I18nNames              *I18nName              `tfsdk:"i18n_names"`
+	GroupMessageType       types.String           `tfsdk:"group_message_type"`
+	ChatMode               types.String           `tfsdk:"chat_mode"`
+	ChatType               types.String           `tfsdk:"chat_type"`
+	JoinMessageVisibility  types.String           `tfsdk:"join_message_visibility"`
+	LeaveMessageVisibility types.String           `tfsdk:"leave_message_visibility"`
+	MembershipApproval     types.String           `tfsdk:"membership_approval"`
+	RestrictedModeSetting  *RestrictedModeSetting `tfsdk:"restricted_mode_setting"`
+	UrgentSetting          types.String           `tfsdk:"urgent_setting"`
+	VideoConferenceSetting types.String           `tfsdk:"video_conference_setting"`
+	EditPermission         types.String           `tfsdk:"edit_permission"`
+	HideMemberCountSetting types.String           `tfsdk:"hide_member_count_setting"`
+	AddMemberPermission    types.String           `tfsdk:"add_member_permission"`
+	ShareCardPermission    types.String           `tfsdk:"share_card_permission"`
+	AtAllPermission        types.String           `tfsdk:"at_all_permission"`
+	OwnerID                types.String           `tfsdk:"owner_id"`
+	OwnerIDType            types.String           `tfsdk:"owner_id_type"`
+}
+
+func (d *GroupChatDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_chat"
+}
+
+func (d *GroupChatDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"chat_id": schema.StringAttribute{
+			Description:         "Unique identity of the group chat to look up. Exactly one of chat_id and name must be set.",
+			MarkdownDescription: "Unique identity of the group chat to look up. Exactly one of `chat_id` and `name` must be set.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			Description:         "Exact name of the group chat to look up, among the group chats the bot can see. It's an error when more than one group chat has this name.",
+			MarkdownDescription: "Exact name of the group chat to look up, among the group chats the bot can see. It's an error when more than one group chat has this name.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"avatar": schema.StringAttribute{
+			Description:         "Image key of the group chat avatar.",
+			MarkdownDescription: "Image key of the group chat avatar.",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			Description:         "Group chat description.",
+			MarkdownDescription: "Group chat description.",
+			Computed:            true,
+		},
+		"i18n_names": schema.SingleNestedAttribute{
+			Description:         "Group chat internationalized names.",
+			MarkdownDescription: "Group chat internationalized names.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"zh_cn": schema.StringAttribute{
+					Description:         "Chinese name.",
+					MarkdownDescription: "Chinese name.",
+					Computed:            true,
+				},
+				"ja_jp": schema.StringAttribute{
+					Description:         "Japanese name.",
+					MarkdownDescription: "Japanese name.",
+					Computed:            true,
+				},
+				"en_us": schema.StringAttribute{
+					Description:         "English name.",
+					MarkdownDescription: "English name.",
+					Computed:            true,
+				},
+			},
+		},
+		"owner_id": schema.StringAttribute{
+			Description:         "ID of the group chat owner.",
+			MarkdownDescription: "ID of the group chat owner.",
+			Computed:            true,
+		},
+		"owner_id_type": schema.StringAttribute{
+			Description:         "Type of owner_id.",
+			MarkdownDescription: "Type of `owner_id`.",
+			Computed:            true,
+		},
+		"group_message_type": schema.StringAttribute{
+			Description:         "Group chat message type.",
+			MarkdownDescription: "Group chat message type.",
+			Computed:            true,
+		},
+		"chat_mode": schema.StringAttribute{
+			Description:         "Group chat mode.",
+			MarkdownDescription: "Group chat mode.",
+			Computed:            true,
+		},
+		"chat_type": schema.StringAttribute{
+			Description:         "Group chat type.",
+			MarkdownDescription: "Group chat type.",
+			Computed:            true,
+		},
+		"join_message_visibility": schema.StringAttribute{
+			Description:         "Group chat join message visibility.",
+			MarkdownDescription: "Group chat join message visibility.",
+			Computed:            true,
+		},
+		"leave_message_visibility": schema.StringAttribute{
+			Description:         "Group chat leave message visibility.",
+			MarkdownDescription: "Group chat leave message visibility.",
+			Computed:            true,
+		},
+		"membership_approval": schema.StringAttribute{
+			Description:         "Group chat membership approval.",
+			MarkdownDescription: "Group chat membership approval.",
+			Computed:            true,
+		},
+		"restricted_mode_setting": schema.SingleNestedAttribute{
+			Description:         "Group chat restricted mode setting.",
+			MarkdownDescription: "Group chat restricted mode setting.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"status": schema.BoolAttribute{
+					Description:         "Whether the restricted mode is enabled.",
+					MarkdownDescription: "Whether the restricted mode is enabled.",
+					Computed:            true,
+				},
+				"screenshot_has_permission_setting": schema.StringAttribute{
+					Description:         "Whether the screenshot permission is enabled.",
+					MarkdownDescription: "Whether the screenshot permission is enabled.",
+					Computed:            true,
+				},
+				"download_has_permission_setting": schema.StringAttribute{
+					Description:         "Whether the download permission is enabled.",
+					MarkdownDescription: "Whether the download permission is enabled.",
+					Computed:            true,
+				},
+				"message_has_permission_setting": schema.StringAttribute{
+					Description:         "Whether the message permission is enabled.",
+					MarkdownDescription: "Whether the message permission is enabled.",
+					Computed:            true,
+				},
+			},
+		},
+		"urgent_setting": schema.StringAttribute{
+			Description:         "Group chat urgent setting.",
+			MarkdownDescription: "Group chat urgent setting.",
+			Computed:            true,
+		},
+		"video_conference_setting": schema.StringAttribute{
+			Description:         "Group chat video conference setting.",
+			MarkdownDescription: "Group chat video conference setting.",
+			Computed:            true,
+		},
+		"edit_permission": schema.StringAttribute{
+			Description:         "Group chat edit permission.",
+			MarkdownDescription: "Group chat edit permission.",
+			Computed:            true,
+		},
+		"hide_member_count_setting": schema.StringAttribute{
+			Description:         "Group chat hide member count setting.",
+			MarkdownDescription: "Group chat hide member count setting.",
+			Computed:            true,
+		},
+		"add_member_permission": schema.StringAttribute{
+			Description:         "Group chat add member permission.",
+			MarkdownDescription: "Group chat add member permission.",
+			Computed:            true,
+		},
+		"share_card_permission": schema.StringAttribute{
+			Description:         "Group chat share card permission.",
+			MarkdownDescription: "Group chat share card permission.",
+			Computed:            true,
+		},
+		"at_all_permission": schema.StringAttribute{
+			Description:         "Group chat at all permission.",
+			MarkdownDescription: "Group chat at all permission.",
+			Computed:            true,
+		},
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Retrieve a group chat in Lark by chat ID or by name",
+		MarkdownDescription: "Retrieve a group chat in Lark by chat ID or by name",
+		Attributes:          attributes,
+	}
+}
+
+func (d *GroupChatDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GroupChatDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("chat_id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *GroupChatDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupChatDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	chatID := data.ChatID.ValueString()
+	if data.ChatID.IsNull() {
+		searchResponse, err := common.GroupChatSearchAPI(ctx, d.client, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Searching Group Chat", err.Error())
+			return
+		}
+
+		// The search also matches partial names and members, so only keep the exact names.
+		chatIDs := []string{}
+		for _, chat := range searchResponse.Data.Items {
+			if chat.Name == data.Name.ValueString() {
+				chatIDs = append(chatIDs, chat.ChatID)
+			}
+		}
+
+		if len(chatIDs) == 0 {
+			resp.Diagnostics.AddError("Group Chat Not Found", fmt.Sprintf("No group chat named %s is visible to the bot", data.Name.ValueString()))
+			return
+		}
+		if len(chatIDs) > 1 {
+			resp.Diagnostics.AddError(
+				"Ambiguous Group Chat Name",
+				fmt.Sprintf("%d group chats are named %s: %s, use chat_id instead", len(chatIDs), data.Name.ValueString(), strings.Join(chatIDs, ", ")),
+			)
+			return
+		}
+		chatID = chatIDs[0]
+	}
+
+	response, err := common.GroupChatGetAPI(ctx, d.client, chatID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading Group Chat", err.Error())
+		return
+	}
+
+	data.ChatID = types.StringValue(chatID)
+	data.Avatar = types.StringValue(response.Data.Avatar)
+	data.Name = types.StringValue(response.Data.Name)
+	data.Description = types.StringValue(response.Data.Description)
+	data.I18nNames = &I18nName{
+		ZhCn: types.StringValue(response.Data.I18nNames.ZhCn),
+		JaJp: types.StringValue(response.Data.I18nNames.JaJp),
+		EnUs: types.StringValue(response.Data.I18nNames.EnUs),
+	}
+	data.GroupMessageType = types.StringValue(response.Data.GroupMessageType)
+	data.ChatMode = types.StringValue(response.Data.ChatMode)
+	data.ChatType = types.StringValue(response.Data.ChatType)
+	data.JoinMessageVisibility = types.StringValue(response.Data.JoinMessageVisibility)
+	data.LeaveMessageVisibility = types.StringValue(response.Data.LeaveMessageVisibility)
+	data.MembershipApproval = types.StringValue(response.Data.MembershipApproval)
+	data.RestrictedModeSetting = &RestrictedModeSetting{
+		Status:                         types.BoolValue(response.Data.RestrictedModeSetting.Status),
+		ScreenshotHasPermissionSetting: types.StringValue(response.Data.RestrictedModeSetting.ScreenshotHasPermissionSetting),
+		DownloadHasPermissionSetting:   types.StringValue(response.Data.RestrictedModeSetting.DownloadHasPermissionSetting),
+		MessageHasPermissionSetting:    types.StringValue(response.Data.RestrictedModeSetting.MessageHasPermissionSetting),
+	}
+	data.UrgentSetting = types.StringValue(response.Data.UrgentSetting)
+	data.VideoConferenceSetting = types.StringValue(response.Data.VideoConferenceSetting)
+	data.EditPermission = types.StringValue(response.Data.EditPermission)
+	data.HideMemberCountSetting = types.StringValue(response.Data.HideMemberCountSetting)
+	data.AddMemberPermission = types.StringValue(response.Data.AddMemberPermission)
+	data.ShareCardPermission = types.StringValue(response.Data.ShareCardPermission)
+	data.AtAllPermission = types.StringValue(response.Data.AtAllPermission)
+	data.OwnerID = types.StringValue(response.Data.OwnerID)
+	data.OwnerIDType = types.StringValue(response.Data.OwnerIDType)
+
+	data.Id = types.StringValue(common.ConstructID(common.DATA_SOURCE, common.GROUP_CHAT, chatID))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &GroupChatLinkDataSource{}
+
+func NewGroupChatLinkDataSource() datasource.DataSource {
+	return &GroupChatLinkDataSource{}
+}
+
+// GroupChatLinkDataSource defines the data source implementation.
+type GroupChatLinkDataSource struct {
+	client *common.LarkClient
+}
+
+// GroupChatLinkDataSourceModel describes the data source data model.
+type GroupChatLinkDataSourceModel struct {
+	BaseResourceModel
+	GroupChatID    types.String `tfsdk:"group_chat_id"`
+	ValidityPeriod types.String `tfsdk:"validity_period"`
+	ShareLink      types.String `tfsdk:"share_link"`
+	ExpireTime     types.String `tfsdk:"expire_time"`
+	IsPermanent    types.Bool   `tfsdk:"is_permanent"`
+}
+
+func (d *GroupChatLinkDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_chat_link"
+}
+
+func (d *GroupChatLinkDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"group_chat_id": schema.StringAttribute{
+			Description:         "Unique identity of the group chat, unique under a single tenant",
+			MarkdownDescription: "Unique identity of the group chat, unique under a single tenant",
+			Required:            true,
+		},
+		"validity_period": schema.StringAttribute{
+			Description:         "How long the link is valid, one of week, year and permanently. Defaults to week.",
+			MarkdownDescription: "How long the link is valid, one of `week`, `year` and `permanently`. Defaults to `week`.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("week", "year", "permanently"),
+			},
+		},
+		"share_link": schema.StringAttribute{
+			Description:         "Link to join the group chat.",
+			MarkdownDescription: "Link to join the group chat.",
+			Computed:            true,
+		},
+		"expire_time": schema.StringAttribute{
+			Description:         "Unix timestamp in seconds when the link expires, empty for a permanent link.",
+			MarkdownDescription: "Unix timestamp in seconds when the link expires, empty for a permanent link.",
+			Computed:            true,
+		},
+		"is_permanent": schema.BoolAttribute{
+			Description:         "Whether the link never expires.",
+			MarkdownDescription: "Whether the link never expires.",
+			Computed:            true,
+		},
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Retrieve the share link of a group chat in Lark. The group chat must allow sharing, and the bot must be able to share it.",
+		MarkdownDescription: "Retrieve the share link of a group chat in Lark. The group chat must allow sharing, and the bot must be able to share it.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *GroupChatLinkDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GroupChatLinkDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupChatLinkDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ValidityPeriod.IsNull() {
+		data.ValidityPeriod = types.StringValue("week")
+	}
+
+	response, err := common.GroupChatLinkAPI(ctx, d.client, data.GroupChatID.ValueString(), common.GroupChatLinkRequest{
+		ValidityPeriod: data.ValidityPeriod.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Getting Group Chat Link", err.Error())
+		return
+	}
+
+	data.ShareLink = types.StringValue(response.Data.ShareLink)
+	data.ExpireTime = types.StringValue(response.Data.ExpireTime)
+	data.IsPermanent = types.BoolValue(response.Data.IsPermanent)
+
+	data.Id = types.StringValue(common.ConstructID(common.DATA_SOURCE, common.GROUP_CHAT_LINK, data.GroupChatID.ValueString()))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
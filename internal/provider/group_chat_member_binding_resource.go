@@ -0,0 +1,317 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	local_validator "github.com/aganisatria/terraform-provider-lark/internal/validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &groupChatMemberBindingResource{}
+var _ resource.ResourceWithConfigValidators = &groupChatMemberBindingResource{}
+var _ resource.ResourceWithImportState = &groupChatMemberBindingResource{}
+var _ resource.ResourceWithMoveState = &groupChatMemberBindingResource{}
+
+// movedMemberIDsPrivateKey stores the members of a moved lark_group_chat_member in private state,
+// so the binding can be adopted without calling the API.
+const movedMemberIDsPrivateKey = "moved_member_ids"
+
+func NewGroupChatMemberBindingResource() resource.Resource {
+	return &groupChatMemberBindingResource{}
+}
+
+// groupChatMemberBindingResource defines the resource implementation.
+// Unlike lark_group_chat_member, it only manages a single member and leaves the other members alone.
+type groupChatMemberBindingResource struct {
+	client *common.LarkClient
+}
+
+// groupChatMemberBindingResourceModel describes the resource data model.
+// fields that need to be configured by user.
+type groupChatMemberBindingResourceModel struct {
+	BaseResourceModel
+	GroupChatID types.String `tfsdk:"group_chat_id"`
+	MemberID    types.String `tfsdk:"member_id"`
+}
+
+func (r *groupChatMemberBindingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_chat_member_binding"
+}
+
+func (r *groupChatMemberBindingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"group_chat_id": schema.StringAttribute{
+			Description:         "Unique identity of the group chat, unique under a single tenant",
+			MarkdownDescription: "Unique identity of the group chat, unique under a single tenant",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"member_id": schema.StringAttribute{
+			Description:         "Member added to the group chat. Can be OpenID (starts with ou) or BotID (starts with cli)",
+			MarkdownDescription: "Member added to the group chat. Can be OpenID (starts with ou) or BotID (starts with cli)",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				// A null member in state comes from a moved lark_group_chat_member, which is adopted in place.
+				stringplanmodifier.RequiresReplaceIf(
+					func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+						resp.RequiresReplace = !req.StateValue.IsNull()
+					},
+					"Changing the member requires replacement, unless the binding was moved from lark_group_chat_member.",
+					"Changing the member requires replacement, unless the binding was moved from `lark_group_chat_member`.",
+				),
+			},
+		},
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Manages a single group chat member in Lark without affecting the other members. A member of lark_group_chat_member can be moved to this resource with a moved block, and the other way around.",
+		MarkdownDescription: "Manages a single group chat member in Lark without affecting the other members. A member of `lark_group_chat_member` can be moved to this resource with a `moved` block, and the other way around.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *groupChatMemberBindingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *groupChatMemberBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data groupChatMemberBindingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := common.GroupChatMemberAddAPI(ctx, r.client, data.GroupChatID.ValueString(), common.GroupChatMemberRequest{
+		IDList: []string{data.MemberID.ValueString()},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Adding Group Chat Member", err.Error())
+		return
+	}
+
+	data.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.GROUP_CHAT_MEMBER_BINDING, data.GroupChatID.ValueString()+"_"+data.MemberID.ValueString()))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *groupChatMemberBindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state groupChatMemberBindingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// INFO: The member list API only returns users, so bots can't be checked and are kept as is.
+	// A null member comes from a moved lark_group_chat_member and is adopted on the next apply.
+	if !state.MemberID.IsNull() && !strings.HasPrefix(state.MemberID.ValueString(), "cli_") {
+		response, err := common.GroupChatMemberGetAPI(ctx, r.client, state.GroupChatID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Getting Group Chat Member", err.Error())
+			return
+		}
+
+		membersInServer := []string{}
+		for _, member := range response.Data.Items {
+			membersInServer = append(membersInServer, member.MemberID)
+		}
+
+		// The member was removed outside of terraform, let terraform add it back.
+		if !slices.Contains(membersInServer, state.MemberID.ValueString()) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	}
+
+	if state.Id.IsNull() || state.Id.ValueString() == "" {
+		state.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.GROUP_CHAT_MEMBER_BINDING, state.GroupChatID.ValueString()+"_"+state.MemberID.ValueString()))
+	}
+	state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is only called to adopt a binding moved from lark_group_chat_member,
+// every other change requires replacement.
+func (r *groupChatMemberBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan groupChatMemberBindingResourceModel
+	var state groupChatMemberBindingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.MemberID.IsNull() {
+		movedMemberIDs := []string{}
+		privateMemberIDs, diags := req.Private.GetKey(ctx, movedMemberIDsPrivateKey)
+		resp.Diagnostics.Append(diags...)
+		if len(privateMemberIDs) > 0 {
+			if err := json.Unmarshal(privateMemberIDs, &movedMemberIDs); err != nil {
+				resp.Diagnostics.AddError("Error Reading Moved Group Chat Members", err.Error())
+				return
+			}
+		}
+
+		// Only call the API when the member was not part of the moved list.
+		if !slices.Contains(movedMemberIDs, plan.MemberID.ValueString()) {
+			_, err := common.GroupChatMemberAddAPI(ctx, r.client, plan.GroupChatID.ValueString(), common.GroupChatMemberRequest{
+				IDList: []string{plan.MemberID.ValueString()},
+			})
+			if err != nil {
+				resp.Diagnostics.AddError("API Error Adding Group Chat Member", err.Error())
+				return
+			}
+		}
+
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, movedMemberIDsPrivateKey, nil)...)
+		plan.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.GROUP_CHAT_MEMBER_BINDING, plan.GroupChatID.ValueString()+"_"+plan.MemberID.ValueString()))
+	} else {
+		plan.Id = state.Id
+	}
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *groupChatMemberBindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state groupChatMemberBindingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A moved binding that was never adopted doesn't own any member.
+	if state.MemberID.IsNull() {
+		return
+	}
+
+	_, err := common.GroupChatMemberDeleteAPI(ctx, r.client, state.GroupChatID.ValueString(), common.GroupChatMemberRequest{
+		IDList: []string{state.MemberID.ValueString()},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Removing Group Chat Member", err.Error())
+		return
+	}
+}
+
+// ImportState imports the binding using "<group_chat_id>:<member_id>".
+func (r *groupChatMemberBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := common.SplitCompositeID(req.ID, 2)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <group_chat_id>:<member_id>, %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_chat_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member_id"), parts[1])...)
+}
+
+// MoveState allows a moved block to turn one member of a lark_group_chat_member into a binding.
+// No API call is made, the member is known from the source state.
+// Resources of other providers are not moved.
+// When the source holds several members, the member is left null and adopted on the next apply.
+// The administrators of the source are not moved, a warning lists them.
+func (r *groupChatMemberBindingResource) MoveState(ctx context.Context) []resource.StateMover {
+	sourceSchema := resource.SchemaResponse{}
+	(&groupChatMemberResource{}).Schema(ctx, resource.SchemaRequest{}, &sourceSchema)
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &sourceSchema.Schema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceProviderAddress != common.PROVIDER_ADDRESS || req.SourceTypeName != "lark_group_chat_member" || req.SourceState == nil {
+					return
+				}
+
+				var source groupChatMemberResourceModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// A binding has no administrators, they are left as they are in Lark.
+				if administratorIDs := common.StringValuesToStrings(source.AdministratorIDs); len(administratorIDs) > 0 {
+					resp.Diagnostics.AddWarning(
+						"Group Chat Administrators Not Moved",
+						fmt.Sprintf("Administrators %s of group chat %s stay administrators in Lark, but are no longer managed by Terraform. Manage them separately, for example with another lark_group_chat_member.", strings.Join(administratorIDs, ", "), source.GroupChatID.ValueString()),
+					)
+				}
+
+				memberIDs := common.StringValuesToStrings(source.MemberIDs)
+				target := groupChatMemberBindingResourceModel{
+					BaseResourceModel: BaseResourceModel{
+						Id:          source.Id,
+						LastUpdated: types.StringValue(time.Now().Format(time.RFC3339)),
+					},
+					GroupChatID: source.GroupChatID,
+					MemberID:    types.StringNull(),
+				}
+
+				if len(memberIDs) == 1 {
+					target.MemberID = types.StringValue(memberIDs[0])
+					target.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.GROUP_CHAT_MEMBER_BINDING, source.GroupChatID.ValueString()+"_"+memberIDs[0]))
+				} else {
+					privateMemberIDs, err := json.Marshal(memberIDs)
+					if err != nil {
+						resp.Diagnostics.AddError("Error Moving Group Chat Members", err.Error())
+						return
+					}
+					resp.Diagnostics.Append(resp.TargetPrivate.SetKey(ctx, movedMemberIDsPrivateKey, privateMemberIDs)...)
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &target)...)
+			},
+		},
+	}
+}
+
+func (r *groupChatMemberBindingResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	if r.client == nil {
+		return []resource.ConfigValidator{}
+	}
+	return []resource.ConfigValidator{
+		local_validator.NewUserIDValidator("member_id", false, true, common.OPEN_ID, r.client),
+	}
+}
@@ -7,19 +7,27 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/aganisatria/terraform-provider-lark/internal/common"
 	local_validator "github.com/aganisatria/terraform-provider-lark/internal/validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &groupChatMemberResource{}
 var _ resource.ResourceWithConfigValidators = &groupChatMemberResource{}
+var _ resource.ResourceWithMoveState = &groupChatMemberResource{}
+var _ resource.ResourceWithModifyPlan = &groupChatMemberResource{}
 
 func NewGroupChatMemberResource() resource.Resource {
 	return &groupChatMemberResource{}
@@ -34,9 +42,13 @@ type groupChatMemberResource struct {
 // fields that need to be configured by user.
 type groupChatMemberResourceModel struct {
 	BaseResourceModel
-	GroupChatID      types.String   `tfsdk:"group_chat_id"`
-	MemberIDs        []types.String `tfsdk:"member_ids"`
-	AdministratorIDs []types.String `tfsdk:"administrator_ids"`
+	GroupChatID         types.String   `tfsdk:"group_chat_id"`
+	MemberIDs           []types.String `tfsdk:"member_ids"`
+	AdministratorIDs    []types.String `tfsdk:"administrator_ids"`
+	SourceDepartmentIDs []types.String `tfsdk:"source_department_ids"`
+	SourceRecursive     types.Bool     `tfsdk:"source_recursive"`
+	SourceEmployeeTypes []types.Int64  `tfsdk:"source_employee_types"`
+	EffectiveMemberIDs  []types.String `tfsdk:"effective_member_ids"`
 }
 
 func (r *groupChatMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -63,6 +75,34 @@ func (r *groupChatMemberResource) Schema(ctx context.Context, req resource.Schem
 			Optional:            true,
 			ElementType:         types.StringType,
 		},
+		"source_department_ids": schema.ListAttribute{
+			Description:         "List of open department IDs whose current users are added to the group chat, on top of member_ids. The departments are expanded on every plan.",
+			MarkdownDescription: "List of open department IDs whose current users are added to the group chat, on top of `member_ids`. The departments are expanded on every plan.",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+		"source_recursive": schema.BoolAttribute{
+			Description:         "Whether the users of every descendant of source_department_ids are added as well. Defaults to false.",
+			MarkdownDescription: "Whether the users of every descendant of `source_department_ids` are added as well. Defaults to `false`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"source_employee_types": schema.ListAttribute{
+			Description:         "Only add the department users with one of these employee types, 1 regular, 2 intern, 3 outsourcing, 4 labor and 5 consultant. All employee types are added when empty.",
+			MarkdownDescription: "Only add the department users with one of these employee types, `1` regular, `2` intern, `3` outsourcing, `4` labor and `5` consultant. All employee types are added when empty.",
+			Optional:            true,
+			ElementType:         types.Int64Type,
+			Validators: []validator.List{
+				listvalidator.ValueInt64sAre(int64validator.OneOf(1, 2, 3, 4, 5)),
+			},
+		},
+		"effective_member_ids": schema.SetAttribute{
+			Description:         "Every member managed by this resource, member_ids merged with the users of source_department_ids. The plan shows exactly which members are added or removed.",
+			MarkdownDescription: "Every member managed by this resource, `member_ids` merged with the users of `source_department_ids`. The plan shows exactly which members are added or removed.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
 	}
 
 	for k, v := range baseAttributes {
@@ -103,10 +143,7 @@ func (r *groupChatMemberResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	addedMembers := []string{}
-	for _, member := range data.MemberIDs {
-		addedMembers = append(addedMembers, member.ValueString())
-	}
+	addedMembers := effectiveGroupChatMemberIDs(data)
 	addedAdministrators := []string{}
 	for _, member := range data.AdministratorIDs {
 		addedAdministrators = append(addedAdministrators, member.ValueString())
@@ -141,7 +178,9 @@ func (r *groupChatMemberResource) Read(ctx context.Context, req resource.ReadReq
 		administrators = append(administrators, member.ValueString())
 	}
 
-	if len(members) > 0 || len(administrators) > 0 {
+	effectiveMembers := effectiveGroupChatMemberIDs(state)
+
+	if len(members) > 0 || len(administrators) > 0 || len(effectiveMembers) > 0 {
 		groupChat, err := common.GroupChatMemberGetAPI(ctx, r.client, state.GroupChatID.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(err.Error(), err.Error())
@@ -167,6 +206,17 @@ func (r *groupChatMemberResource) Read(ctx context.Context, req resource.ReadReq
 			}
 		}
 
+		// Department members that left the group chat are dropped, so the next plan adds them again.
+		// Bots are never listed by lark, so they are kept as is.
+		if state.EffectiveMemberIDs != nil {
+			state.EffectiveMemberIDs = []types.String{}
+			for _, member := range effectiveMembers {
+				if common.Contains(member, groupChatMembersInServer...) || strings.HasPrefix(member, "cli_") {
+					state.EffectiveMemberIDs = append(state.EffectiveMemberIDs, types.StringValue(member))
+				}
+			}
+		}
+
 		// INFO: Since lark doesn't support get group chat member, we need to add it again.
 		errAdd := r.AddHelper(ctx, state, members, administrators, state.GroupChatID.ValueString())
 		if errAdd != nil {
@@ -192,15 +242,8 @@ func (r *groupChatMemberResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	planMembers := []string{}
-	for _, member := range plan.MemberIDs {
-		planMembers = append(planMembers, member.ValueString())
-	}
-
-	stateMembers := []string{}
-	for _, member := range state.MemberIDs {
-		stateMembers = append(stateMembers, member.ValueString())
-	}
+	planMembers := effectiveGroupChatMemberIDs(plan)
+	stateMembers := effectiveGroupChatMemberIDs(state)
 
 	addedMembers := []string{}
 	for _, member := range planMembers {
@@ -267,10 +310,7 @@ func (r *groupChatMemberResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	members := []string{}
-	for _, member := range plan.MemberIDs {
-		members = append(members, member.ValueString())
-	}
+	members := effectiveGroupChatMemberIDs(plan)
 	administrators := []string{}
 	for _, member := range plan.AdministratorIDs {
 		administrators = append(administrators, member.ValueString())
@@ -283,6 +323,150 @@ func (r *groupChatMemberResource) Delete(ctx context.Context, req resource.Delet
 	}
 }
 
+// MoveState allows a moved block to turn a lark_group_chat_member_binding into a member list.
+// No API call is made, the remaining members in the configuration are added on the next apply.
+// Resources of other providers are not moved.
+func (r *groupChatMemberResource) MoveState(ctx context.Context) []resource.StateMover {
+	sourceSchema := resource.SchemaResponse{}
+	(&groupChatMemberBindingResource{}).Schema(ctx, resource.SchemaRequest{}, &sourceSchema)
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &sourceSchema.Schema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceProviderAddress != common.PROVIDER_ADDRESS || req.SourceTypeName != "lark_group_chat_member_binding" || req.SourceState == nil {
+					return
+				}
+
+				var source groupChatMemberBindingResourceModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				target := groupChatMemberResourceModel{
+					BaseResourceModel: BaseResourceModel{
+						Id:          types.StringValue(common.ConstructID(common.RESOURCE, common.GROUP_CHAT_MEMBER, source.GroupChatID.ValueString())),
+						LastUpdated: types.StringValue(time.Now().Format(time.RFC3339)),
+					},
+					GroupChatID: source.GroupChatID,
+				}
+
+				if !source.MemberID.IsNull() {
+					target.MemberIDs = []types.String{source.MemberID}
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &target)...)
+			},
+		},
+	}
+}
+
+// ModifyPlan expands source_department_ids into their current users, so the plan of
+// effective_member_ids shows exactly which members will be added or removed.
+// The administrators are checked against those members, since they may only be members through a department.
+func (r *groupChatMemberResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// plan null means resource is being deleted.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// The departments or members may only be known after apply, e.g. when they are created in the same run.
+	// The lists are read as a whole first, an unknown list can not be read into the model.
+	for _, attribute := range []string{"member_ids", "source_department_ids", "source_employee_types", "administrator_ids"} {
+		var ids types.List
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(attribute), &ids)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if ids.IsUnknown() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("effective_member_ids"), types.SetUnknown(types.StringType))...)
+			return
+		}
+	}
+
+	var plan groupChatMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, id := range append(slices.Clone(plan.MemberIDs), plan.SourceDepartmentIDs...) {
+		if id.IsUnknown() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("effective_member_ids"), types.SetUnknown(types.StringType))...)
+			return
+		}
+	}
+
+	members := []string{}
+	for _, member := range plan.MemberIDs {
+		if !slices.Contains(members, member.ValueString()) {
+			members = append(members, member.ValueString())
+		}
+	}
+
+	departmentMembers, err := r.departmentMemberIDs(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(err.Summary(), err.Detail())
+		return
+	}
+	for _, member := range departmentMembers {
+		if !slices.Contains(members, member) {
+			members = append(members, member)
+		}
+	}
+
+	// Administrators must be members, either through member_ids or through source_department_ids.
+	notMembers := []string{}
+	for _, administrator := range plan.AdministratorIDs {
+		if !administrator.IsUnknown() && !slices.Contains(members, administrator.ValueString()) {
+			notMembers = append(notMembers, administrator.ValueString())
+		}
+	}
+	if len(notMembers) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("administrator_ids"),
+			"Administrators Are Not Members",
+			fmt.Sprintf("Administrators %s are not in effective_member_ids, add them to member_ids or to one of source_department_ids", strings.Join(notMembers, ", ")),
+		)
+		return
+	}
+
+	effectiveMembers := []types.String{}
+	for _, member := range members {
+		effectiveMembers = append(effectiveMembers, types.StringValue(member))
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("effective_member_ids"), effectiveMembers)...)
+}
+
+// departmentMemberIDs lists the open IDs of the current users of source_department_ids,
+// filtered by source_employee_types. Resigned users are skipped.
+func (r *groupChatMemberResource) departmentMemberIDs(ctx context.Context, plan groupChatMemberResourceModel) ([]string, *diag.ErrorDiagnostic) {
+	users, err := listDepartmentUsers(ctx, r.client, common.StringValuesToStrings(plan.SourceDepartmentIDs), plan.SourceRecursive.ValueBool())
+	if err != nil {
+		return nil, err
+	}
+
+	employeeTypes := []int{}
+	for _, employeeType := range plan.SourceEmployeeTypes {
+		employeeTypes = append(employeeTypes, int(employeeType.ValueInt64()))
+	}
+
+	members := []string{}
+	for _, user := range users {
+		if user.Status.IsResigned {
+			continue
+		}
+		if len(employeeTypes) > 0 && !slices.Contains(employeeTypes, user.EmployeeType) {
+			continue
+		}
+		members = append(members, user.OpenID)
+	}
+
+	slices.Sort(members)
+	return slices.Compact(members), nil
+}
+
 func (r *groupChatMemberResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
 	if r.client == nil {
 		return []resource.ConfigValidator{}
@@ -290,7 +474,6 @@ func (r *groupChatMemberResource) ConfigValidators(ctx context.Context) []resour
 	return []resource.ConfigValidator{
 		local_validator.NewUserIDValidator("member_ids", true, true, common.OPEN_ID, r.client),
 		local_validator.NewUserIDValidator("administrator_ids", true, true, common.OPEN_ID, r.client),
-		local_validator.NewListShouldBeMemberOfAnotherListValidator("administrator_ids", "member_ids", r.client),
 	}
 }
 
@@ -365,3 +548,18 @@ func (r *groupChatMemberResource) DeleteHelper(ctx context.Context, plan groupCh
 
 	return nil
 }
+
+// effectiveGroupChatMemberIDs returns the members managed by the resource.
+// State written before source_department_ids existed only has member_ids.
+func effectiveGroupChatMemberIDs(data groupChatMemberResourceModel) []string {
+	source := data.EffectiveMemberIDs
+	if source == nil {
+		source = data.MemberIDs
+	}
+
+	members := []string{}
+	for _, member := range source {
+		members = append(members, member.ValueString())
+	}
+	return members
+}
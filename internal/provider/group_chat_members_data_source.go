@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &GroupChatMembersDataSource{}
+
+func NewGroupChatMembersDataSource() datasource.DataSource {
+	return &GroupChatMembersDataSource{}
+}
+
+// GroupChatMembersDataSource defines the data source implementation.
+type GroupChatMembersDataSource struct {
+	client *common.LarkClient
+}
+
+type GroupChatMemberSummary struct {
+	MemberID   types.String `tfsdk:"member_id"`
+	Name       types.String `tfsdk:"name"`
+	MemberType types.String `tfsdk:"member_type"`
+	IsOwner    types.Bool   `tfsdk:"is_owner"`
+	IsAdmin    types.Bool   `tfsdk:"is_admin"`
+}
+
+// GroupChatMembersDataSourceModel describes the data source data model.
+type GroupChatMembersDataSourceModel struct {
+	BaseResourceModel
+	GroupChatID  types.String             `tfsdk:"group_chat_id"`
+	MemberIDType types.String             `tfsdk:"member_id_type"`
+	Members      []GroupChatMemberSummary `tfsdk:"members"`
+}
+
+func (d *GroupChatMembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_chat_members"
+}
+
+func (d *GroupChatMembersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"group_chat_id": schema.StringAttribute{
+			Description:         "Unique identity of the group chat, unique under a single tenant",
+			MarkdownDescription: "Unique identity of the group chat, unique under a single tenant",
+			Required:            true,
+		},
+		"member_id_type": schema.StringAttribute{
+			Description:         "Type of the returned user IDs, one of open_id, union_id and user_id. Defaults to open_id.",
+			MarkdownDescription: "Type of the returned user IDs, one of `open_id`, `union_id` and `user_id`. Defaults to `open_id`.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(string(common.OPEN_ID), string(common.UNION_ID), string(common.USER_ID)),
+			},
+		},
+		"members": schema.ListNestedAttribute{
+			Description:         "Members of the group chat. Lark only lists the users, so bots are only included when they are administrators.",
+			MarkdownDescription: "Members of the group chat. Lark only lists the users, so bots are only included when they are administrators.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"member_id": schema.StringAttribute{
+						Description:         "ID of the member, of the type given by member_id_type for users, or the app ID for bots.",
+						MarkdownDescription: "ID of the member, of the type given by `member_id_type` for users, or the app ID for bots.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						Description:         "Name of the member.",
+						MarkdownDescription: "Name of the member.",
+						Computed:            true,
+					},
+					"member_type": schema.StringAttribute{
+						Description:         "Type of the member, either user or bot.",
+						MarkdownDescription: "Type of the member, either `user` or `bot`.",
+						Computed:            true,
+					},
+					"is_owner": schema.BoolAttribute{
+						Description:         "Whether the member is the owner of the group chat.",
+						MarkdownDescription: "Whether the member is the owner of the group chat.",
+						Computed:            true,
+					},
+					"is_admin": schema.BoolAttribute{
+						Description:         "Whether the member is an administrator of the group chat.",
+						MarkdownDescription: "Whether the member is an administrator of the group chat.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Retrieve the members of a group chat in Lark. Lark doesn't list the bots of a group chat, so a bot is only included when it is an administrator.",
+		MarkdownDescription: "Retrieve the members of a group chat in Lark. Lark doesn't list the bots of a group chat, so a bot is only included when it is an administrator.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *GroupChatMembersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GroupChatMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupChatMembersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.MemberIDType.IsNull() {
+		data.MemberIDType = types.StringValue(string(common.OPEN_ID))
+	}
+	memberIDType := common.UserIDType(data.MemberIDType.ValueString())
+	groupChatID := data.GroupChatID.ValueString()
+
+	// The owner and administrators come with the same ID type as the members.
+	groupChatResponse, err := common.GroupChatGetWithUserIDTypeAPI(ctx, d.client, groupChatID, memberIDType)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading Group Chat", err.Error())
+		return
+	}
+
+	membersResponse, err := common.GroupChatMemberListAPI(ctx, d.client, groupChatID, memberIDType)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading Group Chat Members", err.Error())
+		return
+	}
+
+	data.Members = []GroupChatMemberSummary{}
+	for _, member := range membersResponse.Data.Items {
+		data.Members = append(data.Members, GroupChatMemberSummary{
+			MemberID:   types.StringValue(member.MemberID),
+			Name:       types.StringValue(member.Name),
+			MemberType: types.StringValue("user"),
+			IsOwner:    types.BoolValue(member.MemberID == groupChatResponse.Data.OwnerID),
+			IsAdmin:    types.BoolValue(slices.Contains(groupChatResponse.Data.UserManagerIDList, member.MemberID)),
+		})
+	}
+
+	// Bots that aren't administrators can't be listed, the members API only returns users.
+	for _, botID := range groupChatResponse.Data.BotManagerIDList {
+		data.Members = append(data.Members, GroupChatMemberSummary{
+			MemberID:   types.StringValue(botID),
+			Name:       types.StringNull(),
+			MemberType: types.StringValue("bot"),
+			IsOwner:    types.BoolValue(botID == groupChatResponse.Data.OwnerID),
+			IsAdmin:    types.BoolValue(true),
+		})
+	}
+
+	data.Id = types.StringValue(common.ConstructID(common.DATA_SOURCE, common.GROUP_CHAT_MEMBERS, groupChatID))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,675 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &groupChatMenuResource{}
+var _ resource.ResourceWithImportState = &groupChatMenuResource{}
+var _ resource.ResourceWithModifyPlan = &groupChatMenuResource{}
+
+// Lark limits the menu to 3 top-level items with up to 5 children each.
+const (
+	groupChatMenuMaxTopLevelItems = 3
+	groupChatMenuMaxChildren      = 5
+)
+
+// Action types of a menu item.
+const (
+	groupChatMenuActionNone         = "NONE"
+	groupChatMenuActionRedirectLink = "REDIRECT_LINK"
+)
+
+func NewGroupChatMenuResource() resource.Resource {
+	return &groupChatMenuResource{}
+}
+
+// groupChatMenuResource defines the resource implementation.
+type groupChatMenuResource struct {
+	client *common.LarkClient
+}
+
+// groupChatMenuActionModel describes what happens when a menu item is clicked, it is shared by both menu levels.
+type groupChatMenuActionModel struct {
+	ActionType  types.String `tfsdk:"action_type"`
+	RedirectURL types.String `tfsdk:"redirect_url"`
+	IosURL      types.String `tfsdk:"ios_url"`
+	AndroidURL  types.String `tfsdk:"android_url"`
+	PcURL       types.String `tfsdk:"pc_url"`
+	WebURL      types.String `tfsdk:"web_url"`
+}
+
+type groupChatMenuChildModel struct {
+	groupChatMenuActionModel
+	MenuItemID types.String `tfsdk:"menu_item_id"`
+	Name       types.String `tfsdk:"name"`
+	ImageKey   types.String `tfsdk:"image_key"`
+}
+
+type groupChatMenuItemModel struct {
+	groupChatMenuActionModel
+	MenuItemID types.String              `tfsdk:"menu_item_id"`
+	Name       types.String              `tfsdk:"name"`
+	ImageKey   types.String              `tfsdk:"image_key"`
+	Children   []groupChatMenuChildModel `tfsdk:"children"`
+}
+
+// groupChatMenuResourceModel describes the resource data model.
+// fields that need to be configured by user.
+type groupChatMenuResourceModel struct {
+	BaseResourceModel
+	GroupChatID types.String             `tfsdk:"group_chat_id"`
+	Items       []groupChatMenuItemModel `tfsdk:"items"`
+}
+
+func (r *groupChatMenuResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_chat_menu"
+}
+
+func (r *groupChatMenuResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"group_chat_id": schema.StringAttribute{
+			Description:         "Unique identity of the group chat, unique under a single tenant",
+			MarkdownDescription: "Unique identity of the group chat, unique under a single tenant",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"items": schema.ListNestedAttribute{
+			Description:         "Top-level menu items, in the order they are shown. Up to 3 items.",
+			MarkdownDescription: "Top-level menu items, in the order they are shown. Up to 3 items.",
+			Required:            true,
+			Validators: []validator.List{
+				listvalidator.SizeBetween(1, groupChatMenuMaxTopLevelItems),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: groupChatMenuActionAttributes(map[string]schema.Attribute{
+					"menu_item_id": schema.StringAttribute{
+						Description:         "Top-level menu item ID.",
+						MarkdownDescription: "Top-level menu item ID.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						Description:         "Menu item name, unique among the top-level items.",
+						MarkdownDescription: "Menu item name, unique among the top-level items.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 20),
+						},
+					},
+					"image_key": schema.StringAttribute{
+						Description:         "Image key of the menu item icon.",
+						MarkdownDescription: "Image key of the menu item icon.",
+						Optional:            true,
+					},
+					"children": schema.ListNestedAttribute{
+						Description:         "Second-level menu items shown when the item is clicked, in the order they are shown. Up to 5 items.",
+						MarkdownDescription: "Second-level menu items shown when the item is clicked, in the order they are shown. Up to 5 items.",
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.SizeBetween(1, groupChatMenuMaxChildren),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: groupChatMenuActionAttributes(map[string]schema.Attribute{
+								"menu_item_id": schema.StringAttribute{
+									Description:         "Second-level menu item ID.",
+									MarkdownDescription: "Second-level menu item ID.",
+									Computed:            true,
+								},
+								"name": schema.StringAttribute{
+									Description:         "Menu item name.",
+									MarkdownDescription: "Menu item name.",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthBetween(1, 20),
+									},
+								},
+								"image_key": schema.StringAttribute{
+									Description:         "Image key of the menu item icon.",
+									MarkdownDescription: "Image key of the menu item icon.",
+									Optional:            true,
+								},
+							},
+								"URL opened by the menu item. Required when action_type is REDIRECT_LINK.",
+								"URL opened by the menu item. Required when `action_type` is `REDIRECT_LINK`.",
+							),
+						},
+					},
+				},
+					"URL opened by the menu item. Required when the item has no children, and not allowed when it has children.",
+					"URL opened by the menu item. Required when the item has no `children`, and not allowed when it has `children`.",
+				),
+			},
+		},
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Manages the menu of a group chat in Lark",
+		MarkdownDescription: "Manages the menu of a group chat in Lark",
+		Attributes:          attributes,
+	}
+}
+
+// groupChatMenuActionAttributes adds the action attributes of a menu item to the given attributes.
+func groupChatMenuActionAttributes(attributes map[string]schema.Attribute, redirectURLDescription string, redirectURLMarkdownDescription string) map[string]schema.Attribute {
+	attributes["action_type"] = schema.StringAttribute{
+		Description:         "What happens when the menu item is clicked, either NONE or REDIRECT_LINK. Defaults to REDIRECT_LINK when a URL is set, and NONE otherwise.",
+		MarkdownDescription: "What happens when the menu item is clicked, either `NONE` or `REDIRECT_LINK`. Defaults to `REDIRECT_LINK` when a URL is set, and `NONE` otherwise.",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(groupChatMenuActionNone, groupChatMenuActionRedirectLink),
+		},
+	}
+	attributes["redirect_url"] = schema.StringAttribute{
+		Description:         redirectURLDescription,
+		MarkdownDescription: redirectURLMarkdownDescription,
+		Optional:            true,
+	}
+	for name, platform := range map[string]string{"ios_url": "iOS", "android_url": "Android", "pc_url": "desktop", "web_url": "web"} {
+		attributes[name] = schema.StringAttribute{
+			Description:         fmt.Sprintf("URL opened by the menu item on %s, instead of redirect_url.", platform),
+			MarkdownDescription: fmt.Sprintf("URL opened by the menu item on %s, instead of `redirect_url`.", platform),
+			Optional:            true,
+		}
+	}
+	return attributes
+}
+
+func (r *groupChatMenuResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *groupChatMenuResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data groupChatMenuResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errDiag := r.SyncHelper(ctx, &data)
+	if errDiag != nil {
+		resp.Diagnostics.AddError(errDiag.Summary(), errDiag.Detail())
+		return
+	}
+
+	data.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.GROUP_CHAT_MENU, data.GroupChatID.ValueString()))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *groupChatMenuResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state groupChatMenuResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := common.GroupChatMenuTreeGetAPI(ctx, r.client, state.GroupChatID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading Group Chat Menu", err.Error())
+		return
+	}
+
+	state.Items = []groupChatMenuItemModel{}
+	for _, topLevel := range response.Data.MenuTree.ChatMenuTopLevels {
+		state.Items = append(state.Items, newGroupChatMenuItemModel(topLevel))
+	}
+
+	if state.Id.IsNull() || state.Id.ValueString() == "" {
+		state.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.GROUP_CHAT_MENU, state.GroupChatID.ValueString()))
+	}
+	state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *groupChatMenuResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan groupChatMenuResourceModel
+	var state groupChatMenuResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errDiag := r.SyncHelper(ctx, &plan)
+	if errDiag != nil {
+		resp.Diagnostics.AddError(errDiag.Summary(), errDiag.Detail())
+		return
+	}
+
+	plan.Id = types.StringValue(state.Id.ValueString())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *groupChatMenuResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state groupChatMenuResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	topLevelIDs := []string{}
+	for _, item := range state.Items {
+		topLevelIDs = append(topLevelIDs, item.MenuItemID.ValueString())
+	}
+
+	if len(topLevelIDs) == 0 {
+		return
+	}
+
+	_, err := common.GroupChatMenuTreeDeleteAPI(ctx, r.client, state.GroupChatID.ValueString(), common.GroupChatMenuTopLevelIDsRequest{
+		ChatMenuTopLevelIDs: topLevelIDs,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Deleting Group Chat Menu", err.Error())
+		return
+	}
+}
+
+// ImportState imports the menu using the group chat ID.
+func (r *groupChatMenuResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("group_chat_id"), req, resp)
+}
+
+// We use modify plan to validate the menu items against each other, since the schema validators only see a single value.
+// It also fills in the action type of the items that don't configure it, so the plan shows what is sent to Lark.
+func (r *groupChatMenuResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// plan null means resource is being deleted.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, config *groupChatMenuResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names := []string{}
+	for i := range plan.Items {
+		item := &plan.Items[i]
+		itemPath := path.Root("items").AtListIndex(i)
+
+		if !item.Name.IsUnknown() {
+			if slices.Contains(names, item.Name.ValueString()) {
+				resp.Diagnostics.AddAttributeError(
+					itemPath.AtName("name"),
+					"Duplicate Menu Item Name",
+					fmt.Sprintf("Menu item name %s is used more than once, every top-level menu item must have a unique name", item.Name.ValueString()),
+				)
+				return
+			}
+			names = append(names, item.Name.ValueString())
+		}
+
+		// The plan and the configuration have the same items, since items isn't computed.
+		configItem := config.Items[i]
+		for j := range item.Children {
+			child := &item.Children[j]
+			if !resolveGroupChatMenuAction(&child.groupChatMenuActionModel, configItem.Children[j].ActionType) {
+				continue
+			}
+
+			errDiag := validateGroupChatMenuAction(child.groupChatMenuActionModel)
+			if errDiag != nil {
+				resp.Diagnostics.AddAttributeError(itemPath.AtName("children").AtListIndex(j).AtName("redirect_url"), errDiag.Summary(), errDiag.Detail())
+				return
+			}
+		}
+
+		if !resolveGroupChatMenuAction(&item.groupChatMenuActionModel, configItem.ActionType) {
+			continue
+		}
+
+		if len(item.Children) > 0 {
+			if groupChatMenuHasURL(item.groupChatMenuActionModel) {
+				resp.Diagnostics.AddAttributeError(
+					itemPath.AtName("redirect_url"),
+					"Redirect URL Not Allowed",
+					"A menu item with children opens its children when clicked, so it can't have a redirect_url",
+				)
+				return
+			}
+
+			if item.ActionType.ValueString() != groupChatMenuActionNone {
+				resp.Diagnostics.AddAttributeError(
+					itemPath.AtName("action_type"),
+					"Action Not Allowed",
+					"A menu item with children opens its children when clicked, so its action_type must be NONE",
+				)
+				return
+			}
+			continue
+		}
+
+		errDiag := validateGroupChatMenuAction(item.groupChatMenuActionModel)
+		if errDiag != nil {
+			resp.Diagnostics.AddAttributeError(itemPath.AtName("redirect_url"), errDiag.Summary(), errDiag.Detail())
+			return
+		}
+
+		if item.RedirectURL.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				itemPath.AtName("redirect_url"),
+				"Redirect URL Required",
+				"A menu item without children must have a redirect_url",
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// resolveGroupChatMenuAction sets the action type from the URLs when it isn't configured.
+// It reports whether the action is known, an unknown action can't be validated yet.
+func resolveGroupChatMenuAction(action *groupChatMenuActionModel, configuredActionType types.String) bool {
+	for _, url := range groupChatMenuURLs(*action) {
+		if url.IsUnknown() {
+			return false
+		}
+	}
+
+	if configuredActionType.IsNull() {
+		action.ActionType = types.StringValue(groupChatMenuActionNone)
+		if groupChatMenuHasURL(*action) {
+			action.ActionType = types.StringValue(groupChatMenuActionRedirectLink)
+		}
+	}
+
+	return !action.ActionType.IsUnknown()
+}
+
+// validateGroupChatMenuAction checks that the URLs of the action match its action type.
+func validateGroupChatMenuAction(action groupChatMenuActionModel) *diag.ErrorDiagnostic {
+	if action.ActionType.ValueString() == groupChatMenuActionNone && groupChatMenuHasURL(action) {
+		errDiag := diag.NewErrorDiagnostic("Redirect URL Not Allowed", "A menu item with action_type NONE does nothing when clicked, so it can't have a URL")
+		return &errDiag
+	}
+
+	if action.ActionType.ValueString() == groupChatMenuActionRedirectLink && action.RedirectURL.IsNull() {
+		errDiag := diag.NewErrorDiagnostic("Redirect URL Required", "A menu item with action_type REDIRECT_LINK must have a redirect_url")
+		return &errDiag
+	}
+
+	return nil
+}
+
+// SyncHelper makes the menu of the group chat match the planned items, matching the existing top-level items by name.
+// Lark can't change the children of an existing item, so a changed top-level item is deleted and created again.
+func (r *groupChatMenuResource) SyncHelper(ctx context.Context, plan *groupChatMenuResourceModel) *diag.ErrorDiagnostic {
+	groupChatID := plan.GroupChatID.ValueString()
+
+	current, err := common.GroupChatMenuTreeGetAPI(ctx, r.client, groupChatID)
+	if err != nil {
+		errDiag := diag.NewErrorDiagnostic("API Error Reading Group Chat Menu", err.Error())
+		return &errDiag
+	}
+
+	existingItems := map[string]common.GroupChatMenuTopLevel{}
+	for _, topLevel := range current.Data.MenuTree.ChatMenuTopLevels {
+		existingItems[newGroupChatMenuItemModel(topLevel).Name.ValueString()] = topLevel
+	}
+
+	plannedNames := []string{}
+	createdItems := []common.GroupChatMenuTopLevel{}
+	for _, item := range plan.Items {
+		plannedNames = append(plannedNames, item.Name.ValueString())
+
+		existing, ok := existingItems[item.Name.ValueString()]
+		if ok && sameGroupChatMenuItem(newGroupChatMenuItemModel(existing), item) {
+			continue
+		}
+		createdItems = append(createdItems, newGroupChatMenuTopLevel(item))
+	}
+
+	deletedIDs := []string{}
+	for name, topLevel := range existingItems {
+		if !slices.Contains(plannedNames, name) || slices.ContainsFunc(createdItems, func(created common.GroupChatMenuTopLevel) bool {
+			return created.ChatMenuItem.Name == name
+		}) {
+			deletedIDs = append(deletedIDs, topLevel.ChatMenuTopLevelID)
+			delete(existingItems, name)
+		}
+	}
+
+	// Delete first, so the menu doesn't go over the top-level item limit while creating.
+	if len(deletedIDs) > 0 {
+		_, err := common.GroupChatMenuTreeDeleteAPI(ctx, r.client, groupChatID, common.GroupChatMenuTopLevelIDsRequest{ChatMenuTopLevelIDs: deletedIDs})
+		if err != nil {
+			errDiag := diag.NewErrorDiagnostic("API Error Deleting Group Chat Menu", err.Error())
+			return &errDiag
+		}
+	}
+
+	if len(createdItems) > 0 {
+		response, err := common.GroupChatMenuTreeCreateAPI(ctx, r.client, groupChatID, common.GroupChatMenuTreeRequest{
+			MenuTree: common.GroupChatMenuTree{ChatMenuTopLevels: createdItems},
+		})
+		if err != nil {
+			errDiag := diag.NewErrorDiagnostic("API Error Creating Group Chat Menu", err.Error())
+			return &errDiag
+		}
+
+		// The create API returns the whole menu tree, including the new items.
+		for _, topLevel := range response.Data.MenuTree.ChatMenuTopLevels {
+			existingItems[newGroupChatMenuItemModel(topLevel).Name.ValueString()] = topLevel
+		}
+	}
+
+	sortedIDs := []string{}
+	for i, item := range plan.Items {
+		existing, ok := existingItems[item.Name.ValueString()]
+		if !ok {
+			errDiag := diag.NewErrorDiagnostic(
+				"API Error Creating Group Chat Menu",
+				fmt.Sprintf("Menu item %s was not found in group chat %s after creating it", item.Name.ValueString(), groupChatID),
+			)
+			return &errDiag
+		}
+
+		// Take the IDs from Lark, but keep the planned values so the state matches the configuration.
+		existingModel := newGroupChatMenuItemModel(existing)
+		plan.Items[i].MenuItemID = existingModel.MenuItemID
+		for j := range plan.Items[i].Children {
+			if j < len(existingModel.Children) {
+				plan.Items[i].Children[j].MenuItemID = existingModel.Children[j].MenuItemID
+			}
+		}
+		sortedIDs = append(sortedIDs, existing.ChatMenuTopLevelID)
+	}
+
+	_, err = common.GroupChatMenuTreeSortAPI(ctx, r.client, groupChatID, common.GroupChatMenuTopLevelIDsRequest{ChatMenuTopLevelIDs: sortedIDs})
+	if err != nil {
+		errDiag := diag.NewErrorDiagnostic("API Error Sorting Group Chat Menu", err.Error())
+		return &errDiag
+	}
+
+	return nil
+}
+
+func newGroupChatMenuItem(name types.String, action groupChatMenuActionModel, imageKey types.String) *common.GroupChatMenuItem {
+	item := &common.GroupChatMenuItem{
+		ActionType: groupChatMenuActionNone,
+		Name:       name.ValueString(),
+		ImageKey:   imageKey.ValueString(),
+	}
+
+	if action.ActionType.ValueString() == groupChatMenuActionRedirectLink {
+		item.ActionType = groupChatMenuActionRedirectLink
+		item.RedirectLink = &common.GroupChatMenuRedirectLink{
+			CommonURL:  action.RedirectURL.ValueString(),
+			IosURL:     action.IosURL.ValueString(),
+			AndroidURL: action.AndroidURL.ValueString(),
+			PcURL:      action.PcURL.ValueString(),
+			WebURL:     action.WebURL.ValueString(),
+		}
+	}
+
+	return item
+}
+
+func newGroupChatMenuTopLevel(item groupChatMenuItemModel) common.GroupChatMenuTopLevel {
+	topLevel := common.GroupChatMenuTopLevel{
+		ChatMenuItem: newGroupChatMenuItem(item.Name, item.groupChatMenuActionModel, item.ImageKey),
+	}
+
+	for _, child := range item.Children {
+		topLevel.Children = append(topLevel.Children, common.GroupChatMenuSecondLevel{
+			ChatMenuItem: newGroupChatMenuItem(child.Name, child.groupChatMenuActionModel, child.ImageKey),
+		})
+	}
+
+	return topLevel
+}
+
+func newGroupChatMenuItemModel(topLevel common.GroupChatMenuTopLevel) groupChatMenuItemModel {
+	item := groupChatMenuItemModel{
+		groupChatMenuActionModel: newGroupChatMenuActionModel(topLevel.ChatMenuItem),
+		MenuItemID:               types.StringValue(topLevel.ChatMenuTopLevelID),
+		Name:                     types.StringNull(),
+		ImageKey:                 types.StringNull(),
+	}
+
+	if topLevel.ChatMenuItem != nil {
+		item.Name = types.StringValue(topLevel.ChatMenuItem.Name)
+		item.ImageKey = groupChatMenuImageKey(topLevel.ChatMenuItem)
+	}
+
+	for _, child := range topLevel.Children {
+		childModel := groupChatMenuChildModel{
+			groupChatMenuActionModel: newGroupChatMenuActionModel(child.ChatMenuItem),
+			MenuItemID:               types.StringValue(child.ChatMenuSecondLevelID),
+			Name:                     types.StringNull(),
+			ImageKey:                 types.StringNull(),
+		}
+		if child.ChatMenuItem != nil {
+			childModel.Name = types.StringValue(child.ChatMenuItem.Name)
+			childModel.ImageKey = groupChatMenuImageKey(child.ChatMenuItem)
+		}
+		item.Children = append(item.Children, childModel)
+	}
+
+	return item
+}
+
+func newGroupChatMenuActionModel(item *common.GroupChatMenuItem) groupChatMenuActionModel {
+	action := groupChatMenuActionModel{
+		ActionType:  types.StringValue(groupChatMenuActionNone),
+		RedirectURL: types.StringNull(),
+		IosURL:      types.StringNull(),
+		AndroidURL:  types.StringNull(),
+		PcURL:       types.StringNull(),
+		WebURL:      types.StringNull(),
+	}
+
+	if item == nil || item.ActionType != groupChatMenuActionRedirectLink || item.RedirectLink == nil {
+		return action
+	}
+
+	action.ActionType = types.StringValue(groupChatMenuActionRedirectLink)
+	action.RedirectURL = groupChatMenuURL(item.RedirectLink.CommonURL)
+	action.IosURL = groupChatMenuURL(item.RedirectLink.IosURL)
+	action.AndroidURL = groupChatMenuURL(item.RedirectLink.AndroidURL)
+	action.PcURL = groupChatMenuURL(item.RedirectLink.PcURL)
+	action.WebURL = groupChatMenuURL(item.RedirectLink.WebURL)
+	return action
+}
+
+func groupChatMenuURL(url string) types.String {
+	if url == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(url)
+}
+
+func groupChatMenuImageKey(item *common.GroupChatMenuItem) types.String {
+	if item.ImageKey == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(item.ImageKey)
+}
+
+func groupChatMenuURLs(action groupChatMenuActionModel) []types.String {
+	return []types.String{action.RedirectURL, action.IosURL, action.AndroidURL, action.PcURL, action.WebURL}
+}
+
+func groupChatMenuHasURL(action groupChatMenuActionModel) bool {
+	return slices.ContainsFunc(groupChatMenuURLs(action), func(url types.String) bool {
+		return !url.IsNull()
+	})
+}
+
+// sameGroupChatMenuAction reports whether both actions have the same type and URLs.
+func sameGroupChatMenuAction(a, b groupChatMenuActionModel) bool {
+	return a.ActionType.Equal(b.ActionType) && slices.EqualFunc(groupChatMenuURLs(a), groupChatMenuURLs(b), func(x, y types.String) bool {
+		return x.Equal(y)
+	})
+}
+
+// sameGroupChatMenuItem reports whether both items have the same values and children, ignoring the IDs.
+func sameGroupChatMenuItem(a, b groupChatMenuItemModel) bool {
+	if !a.Name.Equal(b.Name) || !sameGroupChatMenuAction(a.groupChatMenuActionModel, b.groupChatMenuActionModel) || !a.ImageKey.Equal(b.ImageKey) || len(a.Children) != len(b.Children) {
+		return false
+	}
+
+	for i := range a.Children {
+		if !a.Children[i].Name.Equal(b.Children[i].Name) ||
+			!sameGroupChatMenuAction(a.Children[i].groupChatMenuActionModel, b.Children[i].groupChatMenuActionModel) ||
+			!a.Children[i].ImageKey.Equal(b.Children[i].ImageKey) {
+			return false
+		}
+	}
+
+	return true
+}
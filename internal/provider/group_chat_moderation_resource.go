@@ -0,0 +1,322 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	local_validator "github.com/aganisatria/terraform-provider-lark/internal/validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &groupChatModerationResource{}
+var _ resource.ResourceWithConfigValidators = &groupChatModerationResource{}
+var _ resource.ResourceWithImportState = &groupChatModerationResource{}
+var _ resource.ResourceWithModifyPlan = &groupChatModerationResource{}
+
+const moderationSettingModeratorList = "moderator_list"
+
+func NewGroupChatModerationResource() resource.Resource {
+	return &groupChatModerationResource{}
+}
+
+// groupChatModerationResource defines the resource implementation.
+type groupChatModerationResource struct {
+	client *common.LarkClient
+}
+
+// groupChatModerationResourceModel describes the resource data model.
+// fields that need to be configured by user.
+type groupChatModerationResourceModel struct {
+	BaseResourceModel
+	GroupChatID       types.String   `tfsdk:"group_chat_id"`
+	ModerationSetting types.String   `tfsdk:"moderation_setting"`
+	ModeratorIDs      []types.String `tfsdk:"moderator_ids"`
+}
+
+func (r *groupChatModerationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_chat_moderation"
+}
+
+func (r *groupChatModerationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"group_chat_id": schema.StringAttribute{
+			Description:         "Unique identity of the group chat, unique under a single tenant",
+			MarkdownDescription: "Unique identity of the group chat, unique under a single tenant",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"moderation_setting": schema.StringAttribute{
+			Description:         "Who may post in the group chat. all_members lets everyone post, only_owner only lets the owner and administrators post, and moderator_list only lets the moderator_ids post.",
+			MarkdownDescription: "Who may post in the group chat. `all_members` lets everyone post, `only_owner` only lets the owner and administrators post, and `moderator_list` only lets the `moderator_ids` post.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("all_members", "only_owner", moderationSettingModeratorList),
+			},
+		},
+		"moderator_ids": schema.ListAttribute{
+			Description:         "List of members that may post in the group chat (OpenID of the users). Only used when moderation_setting is moderator_list.",
+			MarkdownDescription: "List of members that may post in the group chat (OpenID of the users). Only used when `moderation_setting` is `moderator_list`.",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Manages who may post in a group chat in Lark. Destroying this resource lets all members post again.",
+		MarkdownDescription: "Manages who may post in a group chat in Lark. Destroying this resource lets all members post again.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *groupChatModerationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *groupChatModerationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data groupChatModerationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.UpdateHelper(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError(err.Summary(), err.Detail())
+		return
+	}
+
+	data.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.GROUP_CHAT_MODERATION, data.GroupChatID.ValueString()))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *groupChatModerationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state groupChatModerationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := common.GroupChatModerationGetAPI(ctx, r.client, state.GroupChatID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading Group Chat Moderation", err.Error())
+		return
+	}
+
+	state.ModerationSetting = types.StringValue(response.Data.ModerationSetting)
+
+	if response.Data.ModerationSetting == moderationSettingModeratorList {
+		moderatorsInServer := []string{}
+		for _, moderator := range response.Data.Items {
+			moderatorsInServer = append(moderatorsInServer, moderator.UserID)
+		}
+
+		// Keep the order in the state when the moderators are the same, to avoid a diff caused by the ordering only.
+		stateModerators := []string{}
+		for _, moderator := range state.ModeratorIDs {
+			stateModerators = append(stateModerators, moderator.ValueString())
+		}
+		if !sameMembers(stateModerators, moderatorsInServer) {
+			state.ModeratorIDs = []types.String{}
+			for _, moderator := range moderatorsInServer {
+				state.ModeratorIDs = append(state.ModeratorIDs, types.StringValue(moderator))
+			}
+		}
+	} else if len(state.ModeratorIDs) > 0 {
+		state.ModeratorIDs = nil
+	}
+
+	if state.Id.IsNull() || state.Id.ValueString() == "" {
+		state.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.GROUP_CHAT_MODERATION, state.GroupChatID.ValueString()))
+	}
+	state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *groupChatModerationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan groupChatModerationResourceModel
+	var state groupChatModerationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.UpdateHelper(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(err.Summary(), err.Detail())
+		return
+	}
+
+	plan.Id = types.StringValue(state.Id.ValueString())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *groupChatModerationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state groupChatModerationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := common.GroupChatSpeechScopesUpdateAPI(ctx, r.client, state.GroupChatID.ValueString(), common.GroupChatSpeechScopesUpdateRequest{
+		ModerationSetting: "all_members",
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Resetting Group Chat Moderation", err.Error())
+		return
+	}
+}
+
+// ImportState imports the moderation using the group chat ID.
+func (r *groupChatModerationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("group_chat_id"), req, resp)
+}
+
+// We use modify plan when we need the moderation setting when validating the moderators.
+func (r *groupChatModerationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// plan null means resource is being deleted.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan *groupChatModerationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.ModerationSetting.IsUnknown() {
+		return
+	}
+
+	if plan.ModerationSetting.ValueString() != moderationSettingModeratorList && len(plan.ModeratorIDs) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("moderator_ids"),
+			"Moderator IDs Require Moderator List Setting",
+			"moderator_ids can only be set when moderation_setting is moderator_list",
+		)
+		return
+	}
+
+	if plan.ModerationSetting.ValueString() == moderationSettingModeratorList && len(plan.ModeratorIDs) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("moderator_ids"),
+			"Moderator IDs Are Required",
+			"moderator_ids must contain at least 1 member when moderation_setting is moderator_list",
+		)
+		return
+	}
+}
+
+func (r *groupChatModerationResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	// There is a mini gap that client not yet initialized before terraform plan is executed, so we need to check if the client is nil
+	if r.client == nil {
+		return []resource.ConfigValidator{}
+	}
+	return []resource.ConfigValidator{
+		local_validator.NewUserIDValidator("moderator_ids", true, false, common.OPEN_ID, r.client),
+	}
+}
+
+// UpdateHelper applies the moderation setting, only sending the moderators that are added or removed
+// compared to the current moderators in the group chat.
+func (r *groupChatModerationResource) UpdateHelper(ctx context.Context, data groupChatModerationResourceModel) *diag.ErrorDiagnostic {
+	current, err := common.GroupChatModerationGetAPI(ctx, r.client, data.GroupChatID.ValueString())
+	if err != nil {
+		errDiag := diag.NewErrorDiagnostic("API Error Reading Group Chat Moderation", err.Error())
+		return &errDiag
+	}
+
+	request := common.GroupChatSpeechScopesUpdateRequest{
+		ModerationSetting: data.ModerationSetting.ValueString(),
+	}
+
+	if data.ModerationSetting.ValueString() == moderationSettingModeratorList {
+		currentModerators := []string{}
+		for _, moderator := range current.Data.Items {
+			currentModerators = append(currentModerators, moderator.UserID)
+		}
+
+		plannedModerators := []string{}
+		for _, moderator := range data.ModeratorIDs {
+			plannedModerators = append(plannedModerators, moderator.ValueString())
+		}
+
+		for _, moderator := range plannedModerators {
+			if !slices.Contains(currentModerators, moderator) {
+				request.ModeratorAddedList = append(request.ModeratorAddedList, moderator)
+			}
+		}
+
+		for _, moderator := range currentModerators {
+			if !slices.Contains(plannedModerators, moderator) {
+				request.ModeratorRemovedList = append(request.ModeratorRemovedList, moderator)
+			}
+		}
+	}
+
+	_, err = common.GroupChatSpeechScopesUpdateAPI(ctx, r.client, data.GroupChatID.ValueString(), request)
+	if err != nil {
+		errDiag := diag.NewErrorDiagnostic("API Error Updating Group Chat Moderation", err.Error())
+		return &errDiag
+	}
+
+	return nil
+}
+
+// sameMembers reports whether both lists contain the same members, regardless of the order.
+func sameMembers(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for _, member := range a {
+		if !slices.Contains(b, member) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -13,13 +13,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &groupChatResource{}
+var _ resource.ResourceWithConfigValidators = &groupChatResource{}
 
 func NewGroupChatResource() resource.Resource {
 	return &groupChatResource{}
@@ -53,6 +57,9 @@ type groupChatResourceModel struct {
 	AddMemberPermission    types.String           `tfsdk:"add_member_permission"`
 	ShareCardPermission    types.String           `tfsdk:"share_card_permission"`
 	AtAllPermission        types.String           `tfsdk:"at_all_permission"`
+	OwnerID                types.String           `tfsdk:"owner_id"`
+	OwnerIDType            types.String           `tfsdk:"owner_id_type"`
+	LeaveChatOnDestroy     types.Bool             `tfsdk:"leave_chat_on_destroy"`
 }
 
 func (r *groupChatResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -68,8 +75,8 @@ func (r *groupChatResource) Schema(ctx context.Context, req resource.SchemaReque
 			Computed:            true,
 		},
 		"avatar": schema.StringAttribute{
-			Description:         "URL group of photo profile. You can check on this first https://open.larksuite.com/document/server-docs/im-v1/image/create",
-			MarkdownDescription: "URL group of photo profile. You can check on this first https://open.larksuite.com/document/server-docs/im-v1/image/create",
+			Description:         "Image key of the group chat avatar. It can be uploaded with the lark_im_image resource, using the avatar image type.",
+			MarkdownDescription: "Image key of the group chat avatar. It can be uploaded with the `lark_im_image` resource, using the `avatar` image type.",
 			Optional:            true,
 		},
 		"name": schema.StringAttribute{
@@ -108,8 +115,27 @@ func (r *groupChatResource) Schema(ctx context.Context, req resource.SchemaReque
 				},
 			},
 		},
-		// We remove owner id, because we dont want bot ability to delete group chat is deleted.
-		// PLACEHOLDER OWNER ID.
+		"owner_id": schema.StringAttribute{
+			Description:         "OpenID of the group chat owner. The group chat is always created by the bot, and the ownership is transferred to this user afterwards. Once transferred, the bot can't take the ownership back.",
+			MarkdownDescription: "OpenID of the group chat owner. The group chat is always created by the bot, and the ownership is transferred to this user afterwards. Once transferred, the bot can't take the ownership back.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"owner_id_type": schema.StringAttribute{
+			Description:         "ID type of the group chat owner. Empty when the bot owns the group chat.",
+			MarkdownDescription: "ID type of the group chat owner. Empty when the bot owns the group chat.",
+			Computed:            true,
+		},
+		"leave_chat_on_destroy": schema.BoolAttribute{
+			Description:         "When the ownership has been transferred to owner_id, make the bot leave the group chat on destroy instead of trying to dissolve it. The group chat itself is kept. Destroy fails if the bot still owns the group chat.",
+			MarkdownDescription: "When the ownership has been transferred to `owner_id`, make the bot leave the group chat on destroy instead of trying to dissolve it. The group chat itself is kept. Destroy fails if the bot still owns the group chat.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
 		"group_message_type": schema.StringAttribute{
 			Description:         "Group chat message type.",
 			MarkdownDescription: "Group chat message type.",
@@ -350,6 +376,11 @@ func (r *groupChatResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	plannedAddMemberPermission := data.AddMemberPermission.ValueString()
+	plannedShareCardPermission := data.ShareCardPermission.ValueString()
+	plannedAtAllPermission := data.AtAllPermission.ValueString()
+	plannedOwnerID := data.OwnerID.ValueString()
+
 	data.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.GROUP_CHAT, groupChatCreateResponse.Data.ChatID))
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
 
@@ -358,6 +389,42 @@ func (r *groupChatResource) Create(ctx context.Context, req resource.CreateReque
 	data.AddMemberPermission = types.StringValue(groupChatCreateResponse.Data.AddMemberPermission)
 	data.ShareCardPermission = types.StringValue(groupChatCreateResponse.Data.ShareCardPermission)
 	data.AtAllPermission = types.StringValue(groupChatCreateResponse.Data.AtAllPermission)
+	data.OwnerID = types.StringValue(groupChatCreateResponse.Data.OwnerID)
+	data.OwnerIDType = types.StringValue(groupChatCreateResponse.Data.OwnerIDType)
+
+	// The create API doesn't accept the permission fields, so they are applied right after the group chat is created.
+	// The ownership is transferred in the same request, since the bot may lose the ability to update the group chat afterwards.
+	if plannedAddMemberPermission != groupChatCreateResponse.Data.AddMemberPermission ||
+		plannedShareCardPermission != groupChatCreateResponse.Data.ShareCardPermission ||
+		plannedAtAllPermission != groupChatCreateResponse.Data.AtAllPermission ||
+		plannedOwnerID != "" {
+		permissionRequestBody := common.GroupChatUpdateRequest{
+			I18nNames:           i18nNames,
+			AddMemberPermission: plannedAddMemberPermission,
+			ShareCardPermission: plannedShareCardPermission,
+			AtAllPermission:     plannedAtAllPermission,
+			OwnerID:             plannedOwnerID,
+		}
+
+		_, err = common.GroupChatUpdateAPI(ctx, r.client, groupChatCreateResponse.Data.ChatID, permissionRequestBody)
+		if err != nil {
+			// Keep the created group chat in state, so terraform can replace it on the next apply instead of leaving it behind.
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.AddError(
+				"API Error Updating Group Chat Permissions",
+				fmt.Sprintf("Group chat %s was created, but its permissions could not be applied: %s", groupChatCreateResponse.Data.ChatID, err.Error()),
+			)
+			return
+		}
+
+		data.AddMemberPermission = types.StringValue(plannedAddMemberPermission)
+		data.ShareCardPermission = types.StringValue(plannedShareCardPermission)
+		data.AtAllPermission = types.StringValue(plannedAtAllPermission)
+		if plannedOwnerID != "" {
+			data.OwnerID = types.StringValue(plannedOwnerID)
+			data.OwnerIDType = types.StringValue(string(common.OPEN_ID))
+		}
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -378,6 +445,8 @@ func (r *groupChatResource) Read(ctx context.Context, req resource.ReadRequest,
 	data.AddMemberPermission = types.StringValue(groupChatGetResponse.Data.AddMemberPermission)
 	data.ShareCardPermission = types.StringValue(groupChatGetResponse.Data.ShareCardPermission)
 	data.AtAllPermission = types.StringValue(groupChatGetResponse.Data.AtAllPermission)
+	data.OwnerID = types.StringValue(groupChatGetResponse.Data.OwnerID)
+	data.OwnerIDType = types.StringValue(groupChatGetResponse.Data.OwnerIDType)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -429,6 +498,11 @@ func (r *groupChatResource) Update(ctx context.Context, req resource.UpdateReque
 		}
 	}
 
+	// Only send the owner when it changes, the ownership can only be transferred by the current owner.
+	if plan.OwnerID.ValueString() != "" && plan.OwnerID.ValueString() != state.OwnerID.ValueString() {
+		requestBody.OwnerID = plan.OwnerID.ValueString()
+	}
+
 	_, err := common.GroupChatUpdateAPI(ctx, r.client, state.ChatID.ValueString(), requestBody)
 	if err != nil {
 		resp.Diagnostics.AddError("API Error Updating Group Chat", err.Error())
@@ -439,6 +513,11 @@ func (r *groupChatResource) Update(ctx context.Context, req resource.UpdateReque
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
 
 	plan.ChatID = types.StringValue(state.ChatID.ValueString())
+	if requestBody.OwnerID != "" {
+		plan.OwnerIDType = types.StringValue(string(common.OPEN_ID))
+	} else {
+		plan.OwnerIDType = state.OwnerIDType
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -452,6 +531,34 @@ func (r *groupChatResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	// Only the owner can dissolve the group chat, so the bot leaves it instead once the ownership is transferred.
+	if plan.LeaveChatOnDestroy.ValueBool() && plan.OwnerID.ValueString() != "" {
+		// The state can be stale, so the owner is read again before the bot gives up the group chat.
+		groupChatGetResponse, err := common.GroupChatGetAPI(ctx, r.client, plan.ChatID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Reading Group Chat", err.Error())
+			return
+		}
+
+		// Lark leaves owner_id empty when the owner is a bot.
+		if groupChatGetResponse.Data.OwnerID == "" {
+			resp.Diagnostics.AddError(
+				"Group Chat Ownership Not Transferred",
+				fmt.Sprintf("The bot still owns group chat %s, so it can't leave it. Transfer the ownership to owner_id first, or set leave_chat_on_destroy to false to dissolve the group chat.", plan.ChatID.ValueString()),
+			)
+			return
+		}
+
+		_, err = common.GroupChatMemberDeleteAPI(ctx, r.client, plan.ChatID.ValueString(), common.GroupChatMemberRequest{
+			IDList: []string{r.client.AppID},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Leaving Group Chat", err.Error())
+			return
+		}
+		return
+	}
+
 	_, err := common.GroupChatDeleteAPI(ctx, r.client, plan.ChatID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("API Error Deleting Group Chat", err.Error())
@@ -470,19 +577,6 @@ func (r *groupChatResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 		return
 	}
 
-	// State null means resource is being created.
-	if req.State.Raw.IsNull() {
-		if plan.AddMemberPermission.ValueString() != "all_members" {
-			resp.Diagnostics.AddError("Add Member Permission Must Be All Members when creating terraform resource", "Add Member Permission Must Be All Members when creating group chat")
-			return
-		}
-
-		if plan.ShareCardPermission.ValueString() != "allowed" {
-			resp.Diagnostics.AddError("Share Card Permission Must Be Allowed when creating terraform resource", "Share Card Permission Must Be Allowed when creating group chat")
-			return
-		}
-	}
-
 	if (plan.AddMemberPermission.ValueString() == "only_owner") && (plan.ShareCardPermission.ValueString() != "not_allowed") {
 		resp.Diagnostics.AddError("If Add Member Permission is Only Owner, Share Card Permission is must be allowed", "If Add Member Permission is Only Owner, Share Card Permission is must be allowed")
 		return
@@ -505,3 +599,13 @@ func (r *groupChatResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 		}
 	}
 }
+
+func (r *groupChatResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	// There is a mini gap that client not yet initialized before terraform plan is executed, so we need to check if the client is nil
+	if r.client == nil {
+		return []resource.ConfigValidator{}
+	}
+	return []resource.ConfigValidator{
+		NewUserIDValidator("owner_id", false, false, common.OPEN_ID, r.client),
+	}
+}
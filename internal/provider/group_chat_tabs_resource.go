@@ -0,0 +1,414 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &groupChatTabsResource{}
+var _ resource.ResourceWithImportState = &groupChatTabsResource{}
+var _ resource.ResourceWithModifyPlan = &groupChatTabsResource{}
+
+// Only the url and doc tabs are managed, the built-in tabs such as the message tab are left alone.
+var managedGroupChatTabTypes = []string{"url", "doc"}
+
+func NewGroupChatTabsResource() resource.Resource {
+	return &groupChatTabsResource{}
+}
+
+// groupChatTabsResource defines the resource implementation.
+type groupChatTabsResource struct {
+	client *common.LarkClient
+}
+
+type groupChatTabModel struct {
+	TabID types.String `tfsdk:"tab_id"`
+	Name  types.String `tfsdk:"name"`
+	Type  types.String `tfsdk:"type"`
+	URL   types.String `tfsdk:"url"`
+}
+
+// groupChatTabsResourceModel describes the resource data model.
+// fields that need to be configured by user.
+type groupChatTabsResourceModel struct {
+	BaseResourceModel
+	GroupChatID types.String        `tfsdk:"group_chat_id"`
+	Tabs        []groupChatTabModel `tfsdk:"tabs"`
+}
+
+func (r *groupChatTabsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_chat_tabs"
+}
+
+func (r *groupChatTabsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"group_chat_id": schema.StringAttribute{
+			Description:         "Unique identity of the group chat, unique under a single tenant",
+			MarkdownDescription: "Unique identity of the group chat, unique under a single tenant",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"tabs": schema.ListNestedAttribute{
+			Description:         "Tabs of the group chat, in the order they are shown after the built-in tabs. URL and doc tabs that are not in this list are deleted.",
+			MarkdownDescription: "Tabs of the group chat, in the order they are shown after the built-in tabs. URL and doc tabs that are not in this list are deleted.",
+			Required:            true,
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(20),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"tab_id": schema.StringAttribute{
+						Description:         "Tab ID.",
+						MarkdownDescription: "Tab ID.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						Description:         "Tab name, unique within the group chat.",
+						MarkdownDescription: "Tab name, unique within the group chat.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 30),
+						},
+					},
+					"type": schema.StringAttribute{
+						Description:         "Tab type, url or doc.",
+						MarkdownDescription: "Tab type, `url` or `doc`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(managedGroupChatTabTypes...),
+						},
+					},
+					"url": schema.StringAttribute{
+						Description:         "URL opened by the tab. For doc tabs, the URL of the document.",
+						MarkdownDescription: "URL opened by the tab. For doc tabs, the URL of the document.",
+						Required:            true,
+					},
+				},
+			},
+		},
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Manages the URL and doc tabs of a group chat in Lark",
+		MarkdownDescription: "Manages the URL and doc tabs of a group chat in Lark",
+		Attributes:          attributes,
+	}
+}
+
+func (r *groupChatTabsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *groupChatTabsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data groupChatTabsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errDiag := r.SyncHelper(ctx, &data)
+	if errDiag != nil {
+		resp.Diagnostics.AddError(errDiag.Summary(), errDiag.Detail())
+		return
+	}
+
+	data.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.GROUP_CHAT_TABS, data.GroupChatID.ValueString()))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *groupChatTabsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state groupChatTabsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := common.GroupChatTabListAPI(ctx, r.client, state.GroupChatID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading Group Chat Tabs", err.Error())
+		return
+	}
+
+	state.Tabs = []groupChatTabModel{}
+	for _, tab := range managedGroupChatTabs(response.Data.ChatTabs) {
+		state.Tabs = append(state.Tabs, groupChatTabModel{
+			TabID: types.StringValue(tab.TabID),
+			Name:  types.StringValue(tab.TabName),
+			Type:  types.StringValue(tab.TabType),
+			URL:   types.StringValue(groupChatTabURL(tab)),
+		})
+	}
+
+	if state.Id.IsNull() || state.Id.ValueString() == "" {
+		state.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.GROUP_CHAT_TABS, state.GroupChatID.ValueString()))
+	}
+	state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *groupChatTabsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan groupChatTabsResourceModel
+	var state groupChatTabsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errDiag := r.SyncHelper(ctx, &plan)
+	if errDiag != nil {
+		resp.Diagnostics.AddError(errDiag.Summary(), errDiag.Detail())
+		return
+	}
+
+	plan.Id = types.StringValue(state.Id.ValueString())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *groupChatTabsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state groupChatTabsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tabIDs := []string{}
+	for _, tab := range state.Tabs {
+		tabIDs = append(tabIDs, tab.TabID.ValueString())
+	}
+
+	if len(tabIDs) == 0 {
+		return
+	}
+
+	_, err := common.GroupChatTabDeleteAPI(ctx, r.client, state.GroupChatID.ValueString(), common.GroupChatTabIDsRequest{
+		TabIDs: tabIDs,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Deleting Group Chat Tabs", err.Error())
+		return
+	}
+}
+
+// ImportState imports the tabs using the group chat ID.
+func (r *groupChatTabsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("group_chat_id"), req, resp)
+}
+
+// We use modify plan to validate the tab names, since the tabs are matched by their name.
+func (r *groupChatTabsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// plan null means resource is being deleted.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan *groupChatTabsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names := []string{}
+	for _, tab := range plan.Tabs {
+		if tab.Name.IsUnknown() {
+			continue
+		}
+
+		if slices.Contains(names, tab.Name.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("tabs"),
+				"Duplicate Tab Name",
+				fmt.Sprintf("Tab name %s is used more than once, every tab must have a unique name", tab.Name.ValueString()),
+			)
+			return
+		}
+		names = append(names, tab.Name.ValueString())
+	}
+}
+
+// SyncHelper makes the url and doc tabs of the group chat match the planned tabs, matching the existing tabs by name.
+// It deletes the tabs that are not planned, updates the changed ones, creates the missing ones, and sorts them.
+func (r *groupChatTabsResource) SyncHelper(ctx context.Context, plan *groupChatTabsResourceModel) *diag.ErrorDiagnostic {
+	groupChatID := plan.GroupChatID.ValueString()
+
+	current, err := common.GroupChatTabListAPI(ctx, r.client, groupChatID)
+	if err != nil {
+		errDiag := diag.NewErrorDiagnostic("API Error Reading Group Chat Tabs", err.Error())
+		return &errDiag
+	}
+
+	existingTabs := map[string]common.GroupChatTab{}
+	builtInTabIDs := []string{}
+	for _, tab := range current.Data.ChatTabs {
+		if slices.Contains(managedGroupChatTabTypes, tab.TabType) {
+			existingTabs[tab.TabName] = tab
+		} else {
+			builtInTabIDs = append(builtInTabIDs, tab.TabID)
+		}
+	}
+
+	plannedNames := []string{}
+	createdTabs := []common.GroupChatTab{}
+	updatedTabs := []common.GroupChatTab{}
+	for _, tab := range plan.Tabs {
+		plannedNames = append(plannedNames, tab.Name.ValueString())
+		planned := newGroupChatTab(tab)
+
+		existing, ok := existingTabs[tab.Name.ValueString()]
+		if !ok {
+			createdTabs = append(createdTabs, planned)
+			continue
+		}
+
+		if existing.TabType != planned.TabType || groupChatTabURL(existing) != tab.URL.ValueString() {
+			planned.TabID = existing.TabID
+			updatedTabs = append(updatedTabs, planned)
+		}
+	}
+
+	deletedTabIDs := []string{}
+	for name, tab := range existingTabs {
+		if !slices.Contains(plannedNames, name) {
+			deletedTabIDs = append(deletedTabIDs, tab.TabID)
+			delete(existingTabs, name)
+		}
+	}
+
+	if len(deletedTabIDs) > 0 {
+		_, err := common.GroupChatTabDeleteAPI(ctx, r.client, groupChatID, common.GroupChatTabIDsRequest{TabIDs: deletedTabIDs})
+		if err != nil {
+			errDiag := diag.NewErrorDiagnostic("API Error Deleting Group Chat Tabs", err.Error())
+			return &errDiag
+		}
+	}
+
+	if len(updatedTabs) > 0 {
+		_, err := common.GroupChatTabUpdateAPI(ctx, r.client, groupChatID, common.GroupChatTabsRequest{ChatTabs: updatedTabs})
+		if err != nil {
+			errDiag := diag.NewErrorDiagnostic("API Error Updating Group Chat Tabs", err.Error())
+			return &errDiag
+		}
+	}
+
+	if len(createdTabs) > 0 {
+		response, err := common.GroupChatTabCreateAPI(ctx, r.client, groupChatID, common.GroupChatTabsRequest{ChatTabs: createdTabs})
+		if err != nil {
+			errDiag := diag.NewErrorDiagnostic("API Error Creating Group Chat Tabs", err.Error())
+			return &errDiag
+		}
+
+		// The create API returns all the tabs of the group chat, including the new ones.
+		for _, tab := range response.Data.ChatTabs {
+			if slices.Contains(managedGroupChatTabTypes, tab.TabType) {
+				existingTabs[tab.TabName] = tab
+			}
+		}
+	}
+
+	sortedTabIDs := append([]string{}, builtInTabIDs...)
+	for i, tab := range plan.Tabs {
+		existing, ok := existingTabs[tab.Name.ValueString()]
+		if !ok {
+			errDiag := diag.NewErrorDiagnostic(
+				"API Error Creating Group Chat Tabs",
+				fmt.Sprintf("Tab %s was not found in group chat %s after creating it", tab.Name.ValueString(), groupChatID),
+			)
+			return &errDiag
+		}
+
+		plan.Tabs[i].TabID = types.StringValue(existing.TabID)
+		sortedTabIDs = append(sortedTabIDs, existing.TabID)
+	}
+
+	_, err = common.GroupChatTabSortAPI(ctx, r.client, groupChatID, common.GroupChatTabIDsRequest{TabIDs: sortedTabIDs})
+	if err != nil {
+		errDiag := diag.NewErrorDiagnostic("API Error Sorting Group Chat Tabs", err.Error())
+		return &errDiag
+	}
+
+	return nil
+}
+
+func newGroupChatTab(tab groupChatTabModel) common.GroupChatTab {
+	content := &common.GroupChatTabContent{}
+	if tab.Type.ValueString() == "doc" {
+		content.Doc = tab.URL.ValueString()
+	} else {
+		content.URL = tab.URL.ValueString()
+	}
+
+	return common.GroupChatTab{
+		TabName:    tab.Name.ValueString(),
+		TabType:    tab.Type.ValueString(),
+		TabContent: content,
+	}
+}
+
+func managedGroupChatTabs(tabs []common.GroupChatTab) []common.GroupChatTab {
+	managed := []common.GroupChatTab{}
+	for _, tab := range tabs {
+		if slices.Contains(managedGroupChatTabTypes, tab.TabType) {
+			managed = append(managed, tab)
+		}
+	}
+	return managed
+}
+
+func groupChatTabURL(tab common.GroupChatTab) string {
+	if tab.TabContent == nil {
+		return ""
+	}
+	if tab.TabType == "doc" {
+		return tab.TabContent.Doc
+	}
+	return tab.TabContent.URL
+}
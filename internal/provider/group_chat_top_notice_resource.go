@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &groupChatTopNoticeResource{}
+var _ resource.ResourceWithImportState = &groupChatTopNoticeResource{}
+var _ resource.ResourceWithModifyPlan = &groupChatTopNoticeResource{}
+
+// Lark identifies the kind of top notice by the action type.
+var groupChatTopNoticeActionTypes = map[string]string{
+	"message":      "1",
+	"announcement": "2",
+}
+
+func NewGroupChatTopNoticeResource() resource.Resource {
+	return &groupChatTopNoticeResource{}
+}
+
+// groupChatTopNoticeResource defines the resource implementation.
+type groupChatTopNoticeResource struct {
+	client *common.LarkClient
+}
+
+// groupChatTopNoticeResourceModel describes the resource data model.
+// fields that need to be configured by user.
+type groupChatTopNoticeResourceModel struct {
+	BaseResourceModel
+	GroupChatID types.String `tfsdk:"group_chat_id"`
+	NoticeType  types.String `tfsdk:"notice_type"`
+	MessageID   types.String `tfsdk:"message_id"`
+}
+
+func (r *groupChatTopNoticeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_chat_top_notice"
+}
+
+func (r *groupChatTopNoticeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"group_chat_id": schema.StringAttribute{
+			Description:         "Unique identity of the group chat, unique under a single tenant",
+			MarkdownDescription: "Unique identity of the group chat, unique under a single tenant",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"notice_type": schema.StringAttribute{
+			Description:         "What is pinned as the top notice, either message or announcement.",
+			MarkdownDescription: "What is pinned as the top notice, either `message` or `announcement`.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("message", "announcement"),
+			},
+		},
+		"message_id": schema.StringAttribute{
+			Description:         "ID of the message to pin. Required when notice_type is message, and not allowed otherwise.",
+			MarkdownDescription: "ID of the message to pin. Required when `notice_type` is `message`, and not allowed otherwise.",
+			Optional:            true,
+		},
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Manages the top notice of a group chat in Lark. Lark doesn't provide an API to get the top notice, so only a dissolved group chat or a recalled message is detected as a removal. A top notice removed by hand in the Lark client is not detected, run terraform apply -replace on the resource to pin it again.",
+		MarkdownDescription: "Manages the top notice of a group chat in Lark. Lark doesn't provide an API to get the top notice, so only a dissolved group chat or a recalled message is detected as a removal. A top notice removed by hand in the Lark client is not detected, run `terraform apply -replace` on the resource to pin it again.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *groupChatTopNoticeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *groupChatTopNoticeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data groupChatTopNoticeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errDiag := r.PutHelper(ctx, data)
+	if errDiag != nil {
+		resp.Diagnostics.AddError(errDiag.Summary(), errDiag.Detail())
+		return
+	}
+
+	data.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.GROUP_CHAT_TOP_NOTICE, data.GroupChatID.ValueString()))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *groupChatTopNoticeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state groupChatTopNoticeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupChatResponse, err := common.GroupChatGetAPI(ctx, r.client, state.GroupChatID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading Group Chat", err.Error())
+		return
+	}
+
+	// The top notice is gone together with the group chat.
+	if groupChatResponse.Data.ChatStatus == "dissolved" || groupChatResponse.Data.ChatStatus == "dissolved_save" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if state.NoticeType.ValueString() == "message" && !state.MessageID.IsNull() {
+		messageResponse, err := common.MessageGetAPI(ctx, r.client, state.MessageID.ValueString())
+		if common.IsAPIErrorCode(err, common.MESSAGE_RECALLED_CODE, common.MESSAGE_DELETED_CODE) {
+			resp.Diagnostics.AddWarning("Pinned Message Deleted", fmt.Sprintf("Message %s has been recalled or deleted, the top notice is pinned again on the next apply.", state.MessageID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Reading Message", err.Error())
+			return
+		}
+
+		// A recalled message is no longer shown as the top notice, let terraform pin it again.
+		if len(messageResponse.Data.Items) == 0 || messageResponse.Data.Items[0].Deleted {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	}
+
+	if state.Id.IsNull() || state.Id.ValueString() == "" {
+		state.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.GROUP_CHAT_TOP_NOTICE, state.GroupChatID.ValueString()))
+	}
+	state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *groupChatTopNoticeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan groupChatTopNoticeResourceModel
+	var state groupChatTopNoticeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errDiag := r.PutHelper(ctx, plan)
+	if errDiag != nil {
+		resp.Diagnostics.AddError(errDiag.Summary(), errDiag.Detail())
+		return
+	}
+
+	plan.Id = types.StringValue(state.Id.ValueString())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *groupChatTopNoticeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state groupChatTopNoticeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := common.GroupChatTopNoticeDeleteAPI(ctx, r.client, state.GroupChatID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Deleting Group Chat Top Notice", err.Error())
+		return
+	}
+}
+
+// ImportState imports the top notice using the group chat ID.
+// Lark doesn't return the current top notice, so the configured notice is put again on the next apply.
+func (r *groupChatTopNoticeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("group_chat_id"), req, resp)
+}
+
+// We use modify plan to validate message_id against notice_type.
+func (r *groupChatTopNoticeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// plan null means resource is being deleted.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan *groupChatTopNoticeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.NoticeType.IsUnknown() || plan.MessageID.IsUnknown() {
+		return
+	}
+
+	if plan.NoticeType.ValueString() == "message" && plan.MessageID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("message_id"),
+			"Message ID Required",
+			"message_id must be set when notice_type is message",
+		)
+		return
+	}
+
+	if plan.NoticeType.ValueString() == "announcement" && !plan.MessageID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("message_id"),
+			"Message ID Not Allowed",
+			"message_id can only be set when notice_type is message",
+		)
+		return
+	}
+}
+
+// PutHelper pins the planned notice, replacing the current top notice of the group chat.
+func (r *groupChatTopNoticeResource) PutHelper(ctx context.Context, plan groupChatTopNoticeResourceModel) *diag.ErrorDiagnostic {
+	_, err := common.GroupChatTopNoticePutAPI(ctx, r.client, plan.GroupChatID.ValueString(), common.GroupChatTopNoticeRequest{
+		ChatTopNotice: []common.GroupChatTopNotice{
+			{
+				ActionType: groupChatTopNoticeActionTypes[plan.NoticeType.ValueString()],
+				MessageID:  plan.MessageID.ValueString(),
+			},
+		},
+	})
+	if err != nil {
+		errDiag := diag.NewErrorDiagnostic("API Error Putting Group Chat Top Notice", err.Error())
+		return &errDiag
+	}
+
+	return nil
+}
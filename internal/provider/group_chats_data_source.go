@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &GroupChatsDataSource{}
+
+func NewGroupChatsDataSource() datasource.DataSource {
+	return &GroupChatsDataSource{}
+}
+
+// GroupChatsDataSource defines the data source implementation.
+type GroupChatsDataSource struct {
+	client *common.LarkClient
+}
+
+type GroupChatSummary struct {
+	ChatID      types.String `tfsdk:"chat_id"`
+	Name        types.String `tfsdk:"name"`
+	Avatar      types.String `tfsdk:"avatar"`
+	Description types.String `tfsdk:"description"`
+	OwnerID     types.String `tfsdk:"owner_id"`
+	OwnerIDType types.String `tfsdk:"owner_id_type"`
+	External    types.Bool   `tfsdk:"external"`
+	ChatStatus  types.String `tfsdk:"chat_status"`
+}
+
+// GroupChatsDataSourceModel describes the data source data model.
+type GroupChatsDataSourceModel struct {
+	BaseResourceModel
+	NameContains types.String       `tfsdk:"name_contains"`
+	Chats        []GroupChatSummary `tfsdk:"chats"`
+}
+
+func (d *GroupChatsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_chats"
+}
+
+func (d *GroupChatsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"name_contains": schema.StringAttribute{
+			Description:         "Only return the group chats whose name contains this value.",
+			MarkdownDescription: "Only return the group chats whose name contains this value.",
+			Optional:            true,
+		},
+		"chats": schema.ListNestedAttribute{
+			Description:         "Group chats the bot belongs to.",
+			MarkdownDescription: "Group chats the bot belongs to.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"chat_id": schema.StringAttribute{
+						Description:         "Unique identity of the group chat.",
+						MarkdownDescription: "Unique identity of the group chat.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						Description:         "Group chat name.",
+						MarkdownDescription: "Group chat name.",
+						Computed:            true,
+					},
+					"avatar": schema.StringAttribute{
+						Description:         "Image key of the group chat avatar.",
+						MarkdownDescription: "Image key of the group chat avatar.",
+						Computed:            true,
+					},
+					"description": schema.StringAttribute{
+						Description:         "Group chat description.",
+						MarkdownDescription: "Group chat description.",
+						Computed:            true,
+					},
+					"owner_id": schema.StringAttribute{
+						Description:         "ID of the group chat owner.",
+						MarkdownDescription: "ID of the group chat owner.",
+						Computed:            true,
+					},
+					"owner_id_type": schema.StringAttribute{
+						Description:         "Type of owner_id.",
+						MarkdownDescription: "Type of `owner_id`.",
+						Computed:            true,
+					},
+					"external": schema.BoolAttribute{
+						Description:         "Whether the group chat is an external group chat.",
+						MarkdownDescription: "Whether the group chat is an external group chat.",
+						Computed:            true,
+					},
+					"chat_status": schema.StringAttribute{
+						Description:         "Group chat status, for example normal or dissolved.",
+						MarkdownDescription: "Group chat status, for example `normal` or `dissolved`.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Retrieve every group chat the bot belongs to in Lark",
+		MarkdownDescription: "Retrieve every group chat the bot belongs to in Lark",
+		Attributes:          attributes,
+	}
+}
+
+func (d *GroupChatsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GroupChatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupChatsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := common.GroupChatListAPI(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Listing Group Chats", err.Error())
+		return
+	}
+
+	data.Chats = []GroupChatSummary{}
+	for _, chat := range response.Data.Items {
+		if !strings.Contains(chat.Name, data.NameContains.ValueString()) {
+			continue
+		}
+
+		data.Chats = append(data.Chats, GroupChatSummary{
+			ChatID:      types.StringValue(chat.ChatID),
+			Name:        types.StringValue(chat.Name),
+			Avatar:      types.StringValue(chat.Avatar),
+			Description: types.StringValue(chat.Description),
+			OwnerID:     types.StringValue(chat.OwnerID),
+			OwnerIDType: types.StringValue(chat.OwnerIDType),
+			External:    types.BoolValue(chat.External),
+			ChatStatus:  types.StringValue(chat.ChatStatus),
+		})
+	}
+
+	data.Id = types.StringValue(common.ConstructID(common.DATA_SOURCE, common.GROUP_CHATS, ""))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &imImageResource{}
+var _ resource.ResourceWithModifyPlan = &imImageResource{}
+
+func NewImImageResource() resource.Resource {
+	return &imImageResource{}
+}
+
+// imImageResource defines the resource implementation.
+type imImageResource struct {
+	client *common.LarkClient
+}
+
+// imImageResourceModel describes the resource data model.
+// fields that need to be configured by user.
+type imImageResourceModel struct {
+	BaseResourceModel
+	Source      types.String `tfsdk:"source"`
+	ImageType   types.String `tfsdk:"image_type"`
+	ContentHash types.String `tfsdk:"content_hash"`
+	ImageKey    types.String `tfsdk:"image_key"`
+}
+
+func (r *imImageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_im_image"
+}
+
+func (r *imImageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"source": schema.StringAttribute{
+			Description:         "Path to the local image file. Supported formats are JPEG, PNG, WEBP, GIF, TIFF, BMP and ICO, up to 10 MB.",
+			MarkdownDescription: "Path to the local image file. Supported formats are JPEG, PNG, WEBP, GIF, TIFF, BMP and ICO, up to 10 MB.",
+			Required:            true,
+		},
+		"image_type": schema.StringAttribute{
+			Description:         "Image type. Use message for images sent in messages, and avatar for avatars such as the group chat avatar.",
+			MarkdownDescription: "Image type. Use `message` for images sent in messages, and `avatar` for avatars such as the group chat avatar.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("message"),
+			Validators: []validator.String{
+				stringvalidator.OneOf("message", "avatar"),
+			},
+		},
+		"content_hash": schema.StringAttribute{
+			Description:         "SHA256 hash of the image file. The image is uploaded again when the content changes.",
+			MarkdownDescription: "SHA256 hash of the image file. The image is uploaded again when the content changes.",
+			Computed:            true,
+		},
+		"image_key": schema.StringAttribute{
+			Description:         "Key of the uploaded image.",
+			MarkdownDescription: "Key of the uploaded image.",
+			Computed:            true,
+		},
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Uploads an image to Lark IM. Lark doesn't provide a way to delete uploaded images, so destroying this resource only removes it from the state.",
+		MarkdownDescription: "Uploads an image to Lark IM. Lark doesn't provide a way to delete uploaded images, so destroying this resource only removes it from the state.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *imImageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *imImageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data imImageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	image, err := os.ReadFile(data.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Image File", err.Error())
+		return
+	}
+
+	response, err := common.ImageUploadAPI(ctx, r.client, data.ImageType.ValueString(), filepath.Base(data.Source.ValueString()), image)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Uploading Image", err.Error())
+		return
+	}
+
+	data.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.IM_IMAGE, response.Data.ImageKey))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	data.ContentHash = types.StringValue(imageContentHash(image))
+	data.ImageKey = types.StringValue(response.Data.ImageKey)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read keeps the state as is, since Lark doesn't provide an API to get the uploaded image metadata.
+func (r *imImageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data imImageResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is only called when the source path changes but the content stays the same, so nothing is uploaded.
+func (r *imImageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan imImageResourceModel
+	var state imImageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	plan.ImageKey = state.ImageKey
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the image from the state, since Lark doesn't provide an API to delete uploaded images.
+func (r *imImageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// We use modify plan to detect changes of the image content, since the file isn't part of the configuration.
+func (r *imImageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// plan null means resource is being deleted.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state *imImageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Source.IsUnknown() {
+		return
+	}
+
+	image, err := os.ReadFile(plan.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Error Reading Image File", err.Error())
+		return
+	}
+
+	plan.ContentHash = types.StringValue(imageContentHash(image))
+
+	// State null means resource is being created.
+	if state != nil {
+		// An uploaded image can't be changed, so a new content or image type means uploading a new image.
+		if state.ContentHash.ValueString() != plan.ContentHash.ValueString() || state.ImageType.ValueString() != plan.ImageType.ValueString() {
+			plan.ImageKey = types.StringUnknown()
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_hash"), path.Root("image_type"))
+		} else {
+			plan.ImageKey = state.ImageKey
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+func imageContentHash(image []byte) string {
+	hash := sha256.Sum256(image)
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,330 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &imMessageResource{}
+var _ resource.ResourceWithModifyPlan = &imMessageResource{}
+
+func NewImMessageResource() resource.Resource {
+	return &imMessageResource{}
+}
+
+// imMessageResource defines the resource implementation.
+type imMessageResource struct {
+	client *common.LarkClient
+}
+
+// imMessageResourceModel describes the resource data model.
+// fields that need to be configured by user.
+type imMessageResourceModel struct {
+	BaseResourceModel
+	ReceiveID     types.String `tfsdk:"receive_id"`
+	ReceiveIDType types.String `tfsdk:"receive_id_type"`
+	MsgType       types.String `tfsdk:"msg_type"`
+	Content       types.String `tfsdk:"content"`
+	Pinned        types.Bool   `tfsdk:"pinned"`
+	UUID          types.String `tfsdk:"uuid"`
+	MessageID     types.String `tfsdk:"message_id"`
+	ChatID        types.String `tfsdk:"chat_id"`
+}
+
+func (r *imMessageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_im_message"
+}
+
+func (r *imMessageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"receive_id": schema.StringAttribute{
+			Description:         "ID of the group chat or user that receives the message, of the type given by receive_id_type.",
+			MarkdownDescription: "ID of the group chat or user that receives the message, of the type given by `receive_id_type`.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"receive_id_type": schema.StringAttribute{
+			Description:         "Type of receive_id, one of chat_id, open_id, user_id, union_id and email. Defaults to chat_id.",
+			MarkdownDescription: "Type of `receive_id`, one of `chat_id`, `open_id`, `user_id`, `union_id` and `email`. Defaults to `chat_id`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("chat_id"),
+			Validators: []validator.String{
+				stringvalidator.OneOf("chat_id", "open_id", "user_id", "union_id", "email"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"msg_type": schema.StringAttribute{
+			Description:         "Message type, one of text, post and interactive.",
+			MarkdownDescription: "Message type, one of `text`, `post` and `interactive`.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("text", "post", "interactive"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"content": schema.StringAttribute{
+			Description:         "Message content as a JSON string in the format of msg_type, for example jsonencode({ text = \"Hello\" }) for a text message. A change edits the message in place. An interactive card must set update_multi to true in its config to be edited.",
+			MarkdownDescription: "Message content as a JSON string in the format of `msg_type`, for example `jsonencode({ text = \"Hello\" })` for a text message. A change edits the message in place. An interactive card must set `update_multi` to `true` in its config to be edited.",
+			Required:            true,
+		},
+		"pinned": schema.BoolAttribute{
+			Description:         "Whether the message is pinned in the chat. Defaults to false.",
+			MarkdownDescription: "Whether the message is pinned in the chat. Defaults to `false`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"uuid": schema.StringAttribute{
+			Description:         "Idempotency key of the message. Lark sends only one message for the same uuid within an hour, so a retried request doesn't send the message twice. Generated when not set.",
+			MarkdownDescription: "Idempotency key of the message. Lark sends only one message for the same `uuid` within an hour, so a retried request doesn't send the message twice. Generated when not set.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 50),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"message_id": schema.StringAttribute{
+			Description:         "ID of the sent message.",
+			MarkdownDescription: "ID of the sent message.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"chat_id": schema.StringAttribute{
+			Description:         "ID of the chat the message was sent to, also for messages sent to a user.",
+			MarkdownDescription: "ID of the chat the message was sent to, also for messages sent to a user.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Sends a message in Lark IM. Destroying this resource recalls the message.",
+		MarkdownDescription: "Sends a message in Lark IM. Destroying this resource recalls the message.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *imMessageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *imMessageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data imMessageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The uuid is generated once here, so every retry of the request sends the same one.
+	if data.UUID.IsUnknown() || data.UUID.IsNull() {
+		uuid, err := common.NewUUID()
+		if err != nil {
+			resp.Diagnostics.AddError("Error Generating Message UUID", err.Error())
+			return
+		}
+		data.UUID = types.StringValue(uuid)
+	}
+
+	response, err := common.MessageSendAPI(ctx, r.client, data.ReceiveIDType.ValueString(), common.MessageSendRequest{
+		ReceiveID: data.ReceiveID.ValueString(),
+		MsgType:   data.MsgType.ValueString(),
+		Content:   data.Content.ValueString(),
+		UUID:      data.UUID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Sending Message", err.Error())
+		return
+	}
+
+	data.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.IM_MESSAGE, response.Data.MessageID))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	data.MessageID = types.StringValue(response.Data.MessageID)
+	data.ChatID = types.StringValue(response.Data.ChatID)
+
+	if data.Pinned.ValueBool() {
+		_, err := common.MessagePinAPI(ctx, r.client, response.Data.MessageID)
+		if err != nil {
+			// The message is already sent, keep it in the state so it isn't sent again.
+			data.Pinned = types.BoolValue(false)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.AddError("API Error Pinning Message", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read only checks that the message still exists, since Lark returns the content in a different format than it's sent.
+func (r *imMessageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state imMessageResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := common.MessageGetAPI(ctx, r.client, state.MessageID.ValueString())
+	if common.IsAPIErrorCode(err, common.MESSAGE_RECALLED_CODE, common.MESSAGE_DELETED_CODE) {
+		resp.Diagnostics.AddWarning("Message Deleted", fmt.Sprintf("Message %s has been recalled or deleted outside of Terraform, removing it from the state.", state.MessageID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	// Any other error keeps the message in the state, removing it would send the message again.
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading Message", err.Error())
+		return
+	}
+
+	if len(response.Data.Items) == 0 || response.Data.Items[0].Deleted {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ChatID = types.StringValue(response.Data.Items[0].ChatID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *imMessageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan imMessageResourceModel
+	var state imMessageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	messageID := state.MessageID.ValueString()
+
+	if !plan.Content.Equal(state.Content) {
+		var err error
+		// Cards are patched, while text and post messages are edited.
+		if plan.MsgType.ValueString() == "interactive" {
+			_, err = common.MessageCardPatchAPI(ctx, r.client, messageID, common.MessageUpdateRequest{
+				Content: plan.Content.ValueString(),
+			})
+		} else {
+			_, err = common.MessageUpdateAPI(ctx, r.client, messageID, common.MessageUpdateRequest{
+				MsgType: plan.MsgType.ValueString(),
+				Content: plan.Content.ValueString(),
+			})
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Updating Message", err.Error())
+			return
+		}
+	}
+
+	if !plan.Pinned.Equal(state.Pinned) {
+		var err error
+		if plan.Pinned.ValueBool() {
+			_, err = common.MessagePinAPI(ctx, r.client, messageID)
+		} else {
+			_, err = common.MessageUnpinAPI(ctx, r.client, messageID)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Updating Message Pin", err.Error())
+			return
+		}
+	}
+
+	plan.Id = types.StringValue(state.Id.ValueString())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete recalls the message, which also removes its pin.
+func (r *imMessageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state imMessageResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := common.MessageRecallAPI(ctx, r.client, state.MessageID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Recalling Message", err.Error())
+		return
+	}
+}
+
+// We use modify plan to validate the content, since Lark only reports an invalid content after sending.
+func (r *imMessageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// plan null means resource is being deleted.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan *imMessageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Content.IsUnknown() {
+		return
+	}
+
+	if !json.Valid([]byte(plan.Content.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("content"),
+			"Invalid Message Content",
+			"content must be a JSON string, use jsonencode to build it",
+		)
+		return
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &jobFamilyResource{}
+var _ resource.ResourceWithImportState = &jobFamilyResource{}
+
+func NewJobFamilyResource() resource.Resource {
+	return &jobFamilyResource{}
+}
+
+// jobFamilyResource defines the resource implementation.
+type jobFamilyResource struct {
+	client *common.LarkClient
+}
+
+// jobFamilyResourceModel describes the resource data model.
+// fields that need to be configured by user.
+type jobFamilyResourceModel struct {
+	BaseResourceModel
+	JobFamilyID       types.String  `tfsdk:"job_family_id"`
+	Name              types.String  `tfsdk:"name"`
+	ParentJobFamilyID types.String  `tfsdk:"parent_job_family_id"`
+	Description       types.String  `tfsdk:"description"`
+	Status            types.Bool    `tfsdk:"status"`
+	I18nName          []I18nContent `tfsdk:"i18n_name"`
+	I18nDescription   []I18nContent `tfsdk:"i18n_description"`
+}
+
+func (r *jobFamilyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job_family"
+}
+
+func (r *jobFamilyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"job_family_id": schema.StringAttribute{
+			Description:         "Job family ID.",
+			MarkdownDescription: "Job family ID.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description:         "Job family name.",
+			MarkdownDescription: "Job family name.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 255),
+			},
+		},
+		"parent_job_family_id": schema.StringAttribute{
+			Description:         "Job family ID of the parent job family, the job family is a top level one when not set.",
+			MarkdownDescription: "Job family ID of the parent job family, the job family is a top level one when not set.",
+			Optional:            true,
+		},
+		"description": schema.StringAttribute{
+			Description:         "Job family description. Defaults to an empty string.",
+			MarkdownDescription: "Job family description. Defaults to an empty string.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+		},
+		"status": schema.BoolAttribute{
+			Description:         "Whether the job family is enabled. Defaults to true.",
+			MarkdownDescription: "Whether the job family is enabled. Defaults to `true`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"i18n_name":        i18nContentAttribute("Internationalized job family name."),
+		"i18n_description": i18nContentAttribute("Internationalized job family description."),
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Manages a job family in Lark",
+		MarkdownDescription: "Manages a job family in Lark",
+		Attributes:          attributes,
+	}
+}
+
+func (r *jobFamilyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *jobFamilyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data jobFamilyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := common.JobFamilyCreateAPI(ctx, r.client, jobFamilyRequest(data))
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Creating Job Family", err.Error())
+		return
+	}
+
+	data.JobFamilyID = types.StringValue(response.Data.JobFamily.JobFamilyID)
+	data.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.JOB_FAMILY, response.Data.JobFamily.JobFamilyID))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *jobFamilyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state jobFamilyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := common.JobFamilyGetAPI(ctx, r.client, state.JobFamilyID.ValueString())
+	if common.IsAPIErrorCode(err, common.JOB_FAMILY_NOT_FOUND_CODE) {
+		resp.Diagnostics.AddWarning(
+			"Job Family Deleted",
+			fmt.Sprintf("Job family %s has been deleted outside of Terraform, removing it from the state.", state.JobFamilyID.ValueString()),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading Job Family", err.Error())
+		return
+	}
+
+	jobFamily := response.Data.JobFamily
+	state.JobFamilyID = types.StringValue(jobFamily.JobFamilyID)
+	state.Name = types.StringValue(jobFamily.Name)
+	if jobFamily.ParentJobFamilyID != "" || !state.ParentJobFamilyID.IsNull() {
+		state.ParentJobFamilyID = types.StringValue(jobFamily.ParentJobFamilyID)
+	}
+	state.Description = types.StringValue(jobFamily.Description)
+	state.Status = types.BoolValue(jobFamily.Status)
+	state.I18nName = i18nContentToModel(jobFamily.I18nName, state.I18nName)
+	state.I18nDescription = i18nContentToModel(jobFamily.I18nDescription, state.I18nDescription)
+
+	if state.Id.IsNull() || state.Id.ValueString() == "" {
+		state.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.JOB_FAMILY, jobFamily.JobFamilyID))
+	}
+	state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jobFamilyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan jobFamilyResourceModel
+	var state jobFamilyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := common.JobFamilyUpdateAPI(ctx, r.client, state.JobFamilyID.ValueString(), jobFamilyRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Updating Job Family", err.Error())
+		return
+	}
+
+	plan.Id = state.Id
+	plan.JobFamilyID = state.JobFamilyID
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jobFamilyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state jobFamilyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := common.JobFamilyDeleteAPI(ctx, r.client, state.JobFamilyID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Deleting Job Family", err.Error())
+		return
+	}
+}
+
+// ImportState imports the job family using its job_family_id.
+func (r *jobFamilyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("job_family_id"), req, resp)
+}
+
+func jobFamilyRequest(data jobFamilyResourceModel) common.JobFamilyRequest {
+	return common.JobFamilyRequest{
+		Name:              data.Name.ValueString(),
+		Description:       data.Description.ValueString(),
+		ParentJobFamilyID: data.ParentJobFamilyID.ValueString(),
+		Status:            data.Status.ValueBool(),
+		I18nName:          i18nContentToRequest(data.I18nName),
+		I18nDescription:   i18nContentToRequest(data.I18nDescription),
+	}
+}
@@ -0,0 +1,297 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &jobLevelResource{}
+var _ resource.ResourceWithImportState = &jobLevelResource{}
+
+func NewJobLevelResource() resource.Resource {
+	return &jobLevelResource{}
+}
+
+// jobLevelResource defines the resource implementation.
+type jobLevelResource struct {
+	client *common.LarkClient
+}
+
+// jobLevelResourceModel describes the resource data model.
+// fields that need to be configured by user.
+type jobLevelResourceModel struct {
+	BaseResourceModel
+	JobLevelID      types.String  `tfsdk:"job_level_id"`
+	Name            types.String  `tfsdk:"name"`
+	Rank            types.Int64   `tfsdk:"rank"`
+	Description     types.String  `tfsdk:"description"`
+	Status          types.Bool    `tfsdk:"status"`
+	I18nName        []I18nContent `tfsdk:"i18n_name"`
+	I18nDescription []I18nContent `tfsdk:"i18n_description"`
+}
+
+func (r *jobLevelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job_level"
+}
+
+func (r *jobLevelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"job_level_id": schema.StringAttribute{
+			Description:         "Job level ID.",
+			MarkdownDescription: "Job level ID.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description:         "Job level name.",
+			MarkdownDescription: "Job level name.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 255),
+			},
+		},
+		"rank": schema.Int64Attribute{
+			Description:         "Job level rank, a higher rank is a more senior level.",
+			MarkdownDescription: "Job level rank, a higher rank is a more senior level.",
+			Required:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		},
+		"description": schema.StringAttribute{
+			Description:         "Job level description. Defaults to an empty string.",
+			MarkdownDescription: "Job level description. Defaults to an empty string.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+		},
+		"status": schema.BoolAttribute{
+			Description:         "Whether the job level is enabled. Defaults to true.",
+			MarkdownDescription: "Whether the job level is enabled. Defaults to `true`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"i18n_name":        i18nContentAttribute("Internationalized job level name."),
+		"i18n_description": i18nContentAttribute("Internationalized job level description."),
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Manages a job level in Lark",
+		MarkdownDescription: "Manages a job level in Lark",
+		Attributes:          attributes,
+	}
+}
+
+func (r *jobLevelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *jobLevelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data jobLevelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := common.JobLevelCreateAPI(ctx, r.client, jobLevelRequest(data))
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Creating Job Level", err.Error())
+		return
+	}
+
+	data.JobLevelID = types.StringValue(response.Data.JobLevel.JobLevelID)
+	data.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.JOB_LEVEL, response.Data.JobLevel.JobLevelID))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *jobLevelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state jobLevelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := common.JobLevelGetAPI(ctx, r.client, state.JobLevelID.ValueString())
+	if common.IsAPIErrorCode(err, common.JOB_LEVEL_NOT_FOUND_CODE) {
+		resp.Diagnostics.AddWarning(
+			"Job Level Deleted",
+			fmt.Sprintf("Job level %s has been deleted outside of Terraform, removing it from the state.", state.JobLevelID.ValueString()),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading Job Level", err.Error())
+		return
+	}
+
+	jobLevel := response.Data.JobLevel
+	state.JobLevelID = types.StringValue(jobLevel.JobLevelID)
+	state.Name = types.StringValue(jobLevel.Name)
+	state.Rank = types.Int64Value(jobLevel.Rank)
+	state.Description = types.StringValue(jobLevel.Description)
+	state.Status = types.BoolValue(jobLevel.Status)
+	state.I18nName = i18nContentToModel(jobLevel.I18nName, state.I18nName)
+	state.I18nDescription = i18nContentToModel(jobLevel.I18nDescription, state.I18nDescription)
+
+	if state.Id.IsNull() || state.Id.ValueString() == "" {
+		state.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.JOB_LEVEL, jobLevel.JobLevelID))
+	}
+	state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jobLevelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan jobLevelResourceModel
+	var state jobLevelResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := common.JobLevelUpdateAPI(ctx, r.client, state.JobLevelID.ValueString(), jobLevelRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Updating Job Level", err.Error())
+		return
+	}
+
+	plan.Id = state.Id
+	plan.JobLevelID = state.JobLevelID
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jobLevelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state jobLevelResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := common.JobLevelDeleteAPI(ctx, r.client, state.JobLevelID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Deleting Job Level", err.Error())
+		return
+	}
+}
+
+// ImportState imports the job level using its job_level_id.
+func (r *jobLevelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("job_level_id"), req, resp)
+}
+
+func jobLevelRequest(data jobLevelResourceModel) common.JobLevelRequest {
+	return common.JobLevelRequest{
+		Name:            data.Name.ValueString(),
+		Description:     data.Description.ValueString(),
+		Rank:            data.Rank.ValueInt64(),
+		Status:          data.Status.ValueBool(),
+		I18nName:        i18nContentToRequest(data.I18nName),
+		I18nDescription: i18nContentToRequest(data.I18nDescription),
+	}
+}
+
+// i18nContentAttribute is the locale and value list of lark_job_level and lark_job_family,
+// in the same shape as the i18n_content of lark_workforce_type.
+func i18nContentAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description:         description,
+		MarkdownDescription: description,
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"locale": schema.StringAttribute{
+					Description:         "Language version, for example zh_cn, ja_jp or en_us.",
+					MarkdownDescription: "Language version, for example `zh_cn`, `ja_jp` or `en_us`.",
+					Required:            true,
+				},
+				"value": schema.StringAttribute{
+					Description:         "Content in that language.",
+					MarkdownDescription: "Content in that language.",
+					Required:            true,
+				},
+			},
+		},
+	}
+}
+
+// i18nContentToRequest always returns a non nil slice, so that an update clears the removed locales.
+func i18nContentToRequest(contents []I18nContent) []common.I18nContent {
+	result := []common.I18nContent{}
+	for _, content := range contents {
+		result = append(result, common.I18nContent{
+			Locale: content.Locale.ValueString(),
+			Value:  content.Value.ValueString(),
+		})
+	}
+	return result
+}
+
+// i18nContentToModel keeps an unset list unset when Lark has no content for it.
+func i18nContentToModel(contents []common.I18nContent, current []I18nContent) []I18nContent {
+	if len(contents) == 0 {
+		if current == nil {
+			return nil
+		}
+		return []I18nContent{}
+	}
+
+	result := []I18nContent{}
+	for _, content := range contents {
+		result = append(result, I18nContent{
+			Locale: types.StringValue(content.Locale),
+			Value:  types.StringValue(content.Value),
+		})
+	}
+	return result
+}
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &JobTitleDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &JobTitleDataSource{}
+
+func NewJobTitleDataSource() datasource.DataSource {
+	return &JobTitleDataSource{}
+}
+
+// JobTitleDataSource defines the data source implementation.
+type JobTitleDataSource struct {
+	client *common.LarkClient
+}
+
+// JobTitleDataSourceModel describes the data source data model.
+type JobTitleDataSourceModel struct {
+	BaseResourceModel
+	JobTitleID types.String  `tfsdk:"job_title_id"`
+	Name       types.String  `tfsdk:"name"`
+	I18nName   []I18nContent `tfsdk:"i18n_name"`
+	Status     types.Bool    `tfsdk:"status"`
+}
+
+func (d *JobTitleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job_title"
+}
+
+func (d *JobTitleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"job_title_id": schema.StringAttribute{
+			Description:         "Job title ID of the job title to look up. Exactly one of job_title_id and name must be set.",
+			MarkdownDescription: "Job title ID of the job title to look up. Exactly one of `job_title_id` and `name` must be set.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			Description:         "Exact name of the job title to look up. Exactly one of job_title_id and name must be set.",
+			MarkdownDescription: "Exact name of the job title to look up. Exactly one of `job_title_id` and `name` must be set.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"i18n_name": i18nContentDataSourceAttribute("Internationalized job title name."),
+		"status": schema.BoolAttribute{
+			Description:         "Whether the job title is enabled.",
+			MarkdownDescription: "Whether the job title is enabled.",
+			Computed:            true,
+		},
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Retrieve a job title in Lark by ID or by name",
+		MarkdownDescription: "Retrieve a job title in Lark by ID or by name",
+		Attributes:          attributes,
+	}
+}
+
+func (d *JobTitleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *JobTitleDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("job_title_id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *JobTitleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data JobTitleDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var jobTitle common.JobTitle
+	if !data.JobTitleID.IsNull() {
+		response, err := common.JobTitleGetAPI(ctx, d.client, data.JobTitleID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Reading Job Title", err.Error())
+			return
+		}
+		jobTitle = response.Data.JobTitle
+	} else {
+		response, err := common.JobTitleListAPI(ctx, d.client)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Listing Job Titles", err.Error())
+			return
+		}
+
+		jobTitles := []common.JobTitle{}
+		for _, candidate := range response.Data.Items {
+			if candidate.Name == data.Name.ValueString() {
+				jobTitles = append(jobTitles, candidate)
+			}
+		}
+
+		if len(jobTitles) == 0 {
+			resp.Diagnostics.AddError("Job Title Not Found", fmt.Sprintf("No job title named %s", data.Name.ValueString()))
+			return
+		}
+		if len(jobTitles) > 1 {
+			jobTitleIDs := []string{}
+			for _, jobTitle := range jobTitles {
+				jobTitleIDs = append(jobTitleIDs, jobTitle.JobTitleID)
+			}
+			resp.Diagnostics.AddError(
+				"Ambiguous Job Title Name",
+				fmt.Sprintf("%d job titles are named %s: %s, use job_title_id instead", len(jobTitles), data.Name.ValueString(), strings.Join(jobTitleIDs, ", ")),
+			)
+			return
+		}
+		jobTitle = jobTitles[0]
+	}
+
+	data.JobTitleID = types.StringValue(jobTitle.JobTitleID)
+	data.Name = types.StringValue(jobTitle.Name)
+	data.I18nName = i18nContentToModel(jobTitle.I18nName, []I18nContent{})
+	data.Status = types.BoolValue(jobTitle.Status)
+
+	data.Id = types.StringValue(common.ConstructID(common.DATA_SOURCE, common.JOB_TITLE, jobTitle.JobTitleID))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func i18nContentDataSourceAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description:         description,
+		MarkdownDescription: description,
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"locale": schema.StringAttribute{
+					Description:         "Language version.",
+					MarkdownDescription: "Language version.",
+					Computed:            true,
+				},
+				"value": schema.StringAttribute{
+					Description:         "Content in that language.",
+					MarkdownDescription: "Content in that language.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
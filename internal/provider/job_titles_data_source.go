@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &JobTitlesDataSource{}
+
+func NewJobTitlesDataSource() datasource.DataSource {
+	return &JobTitlesDataSource{}
+}
+
+// JobTitlesDataSource defines the data source implementation.
+type JobTitlesDataSource struct {
+	client *common.LarkClient
+}
+
+type JobTitleSummary struct {
+	JobTitleID types.String  `tfsdk:"job_title_id"`
+	Name       types.String  `tfsdk:"name"`
+	I18nName   []I18nContent `tfsdk:"i18n_name"`
+	Status     types.Bool    `tfsdk:"status"`
+}
+
+// JobTitlesDataSourceModel describes the data source data model.
+type JobTitlesDataSourceModel struct {
+	BaseResourceModel
+	EnabledOnly types.Bool        `tfsdk:"enabled_only"`
+	JobTitles   []JobTitleSummary `tfsdk:"job_titles"`
+}
+
+func (d *JobTitlesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job_titles"
+}
+
+func (d *JobTitlesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"enabled_only": schema.BoolAttribute{
+			Description:         "Whether only the enabled job titles are returned. Defaults to false.",
+			MarkdownDescription: "Whether only the enabled job titles are returned. Defaults to `false`.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"job_titles": schema.ListNestedAttribute{
+			Description:         "Job titles of the tenant.",
+			MarkdownDescription: "Job titles of the tenant.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"job_title_id": schema.StringAttribute{
+						Description:         "Job title ID.",
+						MarkdownDescription: "Job title ID.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						Description:         "Job title name.",
+						MarkdownDescription: "Job title name.",
+						Computed:            true,
+					},
+					"i18n_name": i18nContentDataSourceAttribute("Internationalized job title name."),
+					"status": schema.BoolAttribute{
+						Description:         "Whether the job title is enabled.",
+						MarkdownDescription: "Whether the job title is enabled.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Retrieve every job title in Lark",
+		MarkdownDescription: "Retrieve every job title in Lark",
+		Attributes:          attributes,
+	}
+}
+
+func (d *JobTitlesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *JobTitlesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data JobTitlesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.EnabledOnly.IsNull() {
+		data.EnabledOnly = types.BoolValue(false)
+	}
+
+	response, err := common.JobTitleListAPI(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Listing Job Titles", err.Error())
+		return
+	}
+
+	data.JobTitles = []JobTitleSummary{}
+	for _, jobTitle := range response.Data.Items {
+		if data.EnabledOnly.ValueBool() && !jobTitle.Status {
+			continue
+		}
+		data.JobTitles = append(data.JobTitles, JobTitleSummary{
+			JobTitleID: types.StringValue(jobTitle.JobTitleID),
+			Name:       types.StringValue(jobTitle.Name),
+			I18nName:   i18nContentToModel(jobTitle.I18nName, []I18nContent{}),
+			Status:     types.BoolValue(jobTitle.Status),
+		})
+	}
+
+	data.Id = types.StringValue(common.ConstructID(common.DATA_SOURCE, common.JOB_TITLES, ""))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
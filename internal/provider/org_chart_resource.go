@@ -0,0 +1,801 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &orgChartResource{}
+var _ resource.ResourceWithModifyPlan = &orgChartResource{}
+var _ resource.ResourceWithImportState = &orgChartResource{}
+
+// orgChartMaxDepth is the number of department levels the departments attribute can nest.
+const orgChartMaxDepth = 5
+
+func NewOrgChartResource() resource.Resource {
+	return &orgChartResource{}
+}
+
+// orgChartResource defines the resource implementation.
+type orgChartResource struct {
+	client *common.LarkClient
+}
+
+// orgChartResourceModel describes the resource data model.
+// The framework cannot describe a recursive schema, so the department tree is kept
+// as a list value and walked through orgChartDepartment.
+type orgChartResourceModel struct {
+	BaseResourceModel
+	RootDepartmentID types.String `tfsdk:"root_department_id"`
+	Departments      types.List   `tfsdk:"departments"`
+	Changes          types.List   `tfsdk:"changes"`
+}
+
+type orgChartDepartment struct {
+	DepartmentID     types.String
+	Name             types.String
+	I18nName         types.Object
+	Leaders          types.List
+	Order            types.String
+	OpenDepartmentID types.String
+	Children         []*orgChartDepartment
+	ChildrenNull     bool
+}
+
+// orgChartEntry is a department of the flattened tree, parentID is the custom
+// department ID of its parent and empty for the departments under the root.
+type orgChartEntry struct {
+	department *orgChartDepartment
+	parentID   string
+}
+
+// orgChartLive is the department tree under the root as it currently is in Lark, keyed by custom department ID.
+type orgChartLive struct {
+	departments map[string]common.Department
+	parentIDs   map[string]string
+	depths      map[string]int
+	order       []string
+}
+
+// orgChartStep is what has to happen to a department of the tree, live is nil when the department is created.
+type orgChartStep struct {
+	department *orgChartDepartment
+	parentID   string
+	live       *common.Department
+	move       bool
+	rename     bool
+	update     bool
+}
+
+var orgChartI18nNameAttrTypes = map[string]attr.Type{
+	"zh_cn": types.StringType,
+	"ja_jp": types.StringType,
+	"en_us": types.StringType,
+}
+
+var orgChartLeaderAttrTypes = map[string]attr.Type{
+	"leader_type": types.Int64Type,
+	"leader_id":   types.StringType,
+}
+
+func (r *orgChartResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org_chart"
+}
+
+func (r *orgChartResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	departments := orgChartDepartmentsAttribute(1)
+	departments.Description = fmt.Sprintf("Departments directly under the root department. Every department under the root that is not listed is deleted, departments can nest up to %d levels deep.", orgChartMaxDepth)
+	departments.MarkdownDescription = fmt.Sprintf("Departments directly under the root department. Every department under the root that is not listed is deleted, departments can nest up to %d levels deep.", orgChartMaxDepth)
+	departments.Optional = false
+	departments.Required = true
+
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"root_department_id": schema.StringAttribute{
+			Description:         "Open department ID of the department whose subtree is managed, 0 for the root department of the tenant.",
+			MarkdownDescription: "Open department ID of the department whose subtree is managed, `0` for the root department of the tenant.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"departments": departments,
+		"changes": schema.ListAttribute{
+			Description:         "Structural changes planned against the live department tree, in the order they are applied: creates, moves and renames parent first, then deletes children first.",
+			MarkdownDescription: "Structural changes planned against the live department tree, in the order they are applied: creates, moves and renames parent first, then deletes children first.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Manages the whole department tree under a root department in Lark",
+		MarkdownDescription: "Manages the whole department tree under a root department in Lark",
+		Attributes:          attributes,
+	}
+}
+
+func orgChartDepartmentsAttribute(depth int) schema.ListNestedAttribute {
+	attributes := map[string]schema.Attribute{
+		"department_id": schema.StringAttribute{
+			Description:         "Department's custom department ID, used to match the department with the live tree.",
+			MarkdownDescription: "Department's custom department ID, used to match the department with the live tree.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtMost(64),
+				stringvalidator.RegexMatches(regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_\-@.]{0,63}$`), "must be a valid department ID"),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description:         "Department name.",
+			MarkdownDescription: "Department name.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"i18n_name": schema.SingleNestedAttribute{
+			Description:         "Internationalized department name, left untouched when not set.",
+			MarkdownDescription: "Internationalized department name, left untouched when not set.",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"zh_cn": schema.StringAttribute{
+					Description:         "Department's Chinese name.",
+					MarkdownDescription: "Department's Chinese name.",
+					Optional:            true,
+				},
+				"ja_jp": schema.StringAttribute{
+					Description:         "Department's Japanese name.",
+					MarkdownDescription: "Department's Japanese name.",
+					Optional:            true,
+				},
+				"en_us": schema.StringAttribute{
+					Description:         "Department's English name.",
+					MarkdownDescription: "Department's English name.",
+					Optional:            true,
+				},
+			},
+		},
+		"leaders": schema.ListNestedAttribute{
+			Description:         "Head of department, left untouched when not set.",
+			MarkdownDescription: "Head of department, left untouched when not set.",
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"leader_type": schema.Int64Attribute{
+						Description:         "Person in charge type, 1 main and 2 deputy.",
+						MarkdownDescription: "Person in charge type, `1` main and `2` deputy.",
+						Required:            true,
+						Validators: []validator.Int64{
+							int64validator.OneOf(1, 2),
+						},
+					},
+					"leader_id": schema.StringAttribute{
+						Description:         "Person in charge ID.",
+						MarkdownDescription: "Person in charge ID.",
+						Required:            true,
+					},
+				},
+			},
+		},
+		"order": schema.StringAttribute{
+			Description:         "Department order among the departments at the same level, left untouched when not set.",
+			MarkdownDescription: "Department order among the departments at the same level, left untouched when not set.",
+			Optional:            true,
+		},
+		"open_department_id": schema.StringAttribute{
+			Description:         "Department's open department ID.",
+			MarkdownDescription: "Department's open department ID.",
+			Computed:            true,
+		},
+	}
+
+	if depth < orgChartMaxDepth {
+		attributes["children"] = orgChartDepartmentsAttribute(depth + 1)
+	}
+
+	return schema.ListNestedAttribute{
+		Description:         "Departments directly under the department.",
+		MarkdownDescription: "Departments directly under the department.",
+		Optional:            true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: attributes,
+		},
+	}
+}
+
+func (r *orgChartResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *orgChartResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data orgChartResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	departments := orgChartDepartmentsFromList(data.Departments)
+	if errDiag := r.applyOrgChart(ctx, data.RootDepartmentID.ValueString(), departments); errDiag != nil {
+		resp.Diagnostics.AddError(errDiag.Summary(), errDiag.Detail())
+		return
+	}
+
+	data.Departments = orgChartDepartmentsToList(departments, false, 1)
+	data.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.ORG_CHART, data.RootDepartmentID.ValueString()))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *orgChartResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data orgChartResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	live, errDiag := r.readLiveOrgChart(ctx, data.RootDepartmentID.ValueString())
+	if errDiag != nil {
+		resp.Diagnostics.AddError(errDiag.Summary(), errDiag.Detail())
+		return
+	}
+
+	// Imported org charts have no departments in the state yet.
+	current := []*orgChartDepartment{}
+	if !data.Departments.IsNull() {
+		current = orgChartDepartmentsFromList(data.Departments)
+	}
+	templates := map[string]*orgChartDepartment{}
+	for _, entry := range flattenOrgChart(current, "") {
+		templates[entry.department.DepartmentID.ValueString()] = entry.department
+	}
+
+	data.Departments = orgChartDepartmentsToList(buildOrgChart(live, "", current, templates, 1), false, 1)
+	data.Changes = types.ListValueMust(types.StringType, []attr.Value{})
+	data.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.ORG_CHART, data.RootDepartmentID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *orgChartResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan orgChartResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	departments := orgChartDepartmentsFromList(plan.Departments)
+	if errDiag := r.applyOrgChart(ctx, plan.RootDepartmentID.ValueString(), departments); errDiag != nil {
+		resp.Diagnostics.AddError(errDiag.Summary(), errDiag.Detail())
+		return
+	}
+
+	plan.Departments = orgChartDepartmentsToList(departments, false, 1)
+	plan.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.ORG_CHART, plan.RootDepartmentID.ValueString()))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *orgChartResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state orgChartResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Walking the flattened tree backwards deletes every child before its parent.
+	entries := flattenOrgChart(orgChartDepartmentsFromList(state.Departments), "")
+	for i := len(entries) - 1; i >= 0; i-- {
+		_, err := common.DepartmentDeleteAPI(ctx, r.client, entries[i].department.OpenDepartmentID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Deleting Department", err.Error())
+			return
+		}
+	}
+}
+
+func (r *orgChartResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("root_department_id"), req, resp)
+}
+
+// We use modify plan to diff the configured tree against the live tree, so the plan lists every structural change.
+func (r *orgChartResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// plan null means resource is being deleted.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan orgChartResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RootDepartmentID.IsUnknown() || !orgChartKnown(plan.Departments) {
+		plan.Changes = types.ListUnknown(types.StringType)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	departments := orgChartDepartmentsFromList(plan.Departments)
+	entries := flattenOrgChart(departments, "")
+	seen := map[string]bool{}
+	for _, entry := range entries {
+		departmentID := entry.department.DepartmentID.ValueString()
+		if seen[departmentID] {
+			resp.Diagnostics.AddError("Duplicate Department ID", fmt.Sprintf("Department %s appears more than once in the org chart", departmentID))
+			return
+		}
+		seen[departmentID] = true
+	}
+
+	live, errDiag := r.readLiveOrgChart(ctx, plan.RootDepartmentID.ValueString())
+	if errDiag != nil {
+		resp.Diagnostics.AddError(errDiag.Summary(), errDiag.Detail())
+		return
+	}
+
+	steps, deletes := planOrgChart(entries, live)
+	for _, step := range steps {
+		if step.live != nil {
+			step.department.OpenDepartmentID = types.StringValue(step.live.OpenDepartmentID)
+			continue
+		}
+
+		// Checking if the department id is available.
+		departmentID := step.department.DepartmentID.ValueString()
+		_, err := common.DepartmentGetByDepartmentIDAPI(ctx, r.client, departmentID)
+		if err == nil {
+			resp.Diagnostics.AddError("API Error Getting Department", fmt.Sprintf("Department %s already exists outside of the org chart", departmentID))
+			return
+		}
+		step.department.OpenDepartmentID = types.StringUnknown()
+	}
+
+	changes := []attr.Value{}
+	for _, change := range describeOrgChart(steps, deletes, live) {
+		changes = append(changes, types.StringValue(change))
+	}
+
+	plan.Departments = orgChartDepartmentsToList(departments, false, 1)
+	plan.Changes = types.ListValueMust(types.StringType, changes)
+	if len(changes) > 0 {
+		plan.LastUpdated = types.StringUnknown()
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// applyOrgChart creates, moves and renames the departments parent first, then deletes the
+// departments missing from the tree children first. The open department IDs are filled in.
+func (r *orgChartResource) applyOrgChart(ctx context.Context, rootDepartmentID string, departments []*orgChartDepartment) *diag.ErrorDiagnostic {
+	live, errDiag := r.readLiveOrgChart(ctx, rootDepartmentID)
+	if errDiag != nil {
+		return errDiag
+	}
+
+	openDepartmentIDs := map[string]string{}
+	for departmentID, department := range live.departments {
+		openDepartmentIDs[departmentID] = department.OpenDepartmentID
+	}
+
+	steps, deletes := planOrgChart(flattenOrgChart(departments, ""), live)
+	for _, step := range steps {
+		department := step.department
+		departmentID := department.DepartmentID.ValueString()
+		parentOpenDepartmentID := rootDepartmentID
+		if step.parentID != "" {
+			parentOpenDepartmentID = openDepartmentIDs[step.parentID]
+		}
+
+		switch {
+		case step.live == nil:
+			response, err := common.DepartmentCreateAPI(ctx, r.client, common.DepartmentCreateRequest{
+				BaseDepartment: common.BaseDepartment{
+					Name:               department.Name.ValueString(),
+					I18nName:           orgChartI18nName(department.I18nName),
+					ParentDepartmentID: parentOpenDepartmentID,
+					Order:              department.Order.ValueString(),
+					Leaders:            orgChartLeaders(department.Leaders),
+				},
+				DepartmentID: departmentID,
+			})
+			if err != nil {
+				errDiag := diag.NewErrorDiagnostic("API Error Creating Department", err.Error())
+				return &errDiag
+			}
+			openDepartmentIDs[departmentID] = response.Data.Department.OpenDepartmentID
+		case step.move || step.rename || step.update:
+			// The update replaces the whole department, so it starts from the live one.
+			request := step.live.BaseDepartment
+			request.Name = department.Name.ValueString()
+			request.ParentDepartmentID = parentOpenDepartmentID
+			request.CreateGroupChat = false
+			if !department.I18nName.IsNull() {
+				request.I18nName = orgChartI18nName(department.I18nName)
+			}
+			if !department.Leaders.IsNull() {
+				request.Leaders = orgChartLeaders(department.Leaders)
+			}
+			if !department.Order.IsNull() {
+				request.Order = department.Order.ValueString()
+			}
+
+			_, err := common.DepartmentUpdateAPI(ctx, r.client, step.live.OpenDepartmentID, common.DepartmentUpdateRequest{
+				BaseDepartment: request,
+			})
+			if err != nil {
+				errDiag := diag.NewErrorDiagnostic("API Error Updating Department", err.Error())
+				return &errDiag
+			}
+		}
+
+		department.OpenDepartmentID = types.StringValue(openDepartmentIDs[departmentID])
+	}
+
+	for _, department := range deletes {
+		_, err := common.DepartmentDeleteAPI(ctx, r.client, department.OpenDepartmentID)
+		if err != nil {
+			errDiag := diag.NewErrorDiagnostic("API Error Deleting Department", err.Error())
+			return &errDiag
+		}
+	}
+
+	return nil
+}
+
+// readLiveOrgChart lists every department under the root. Departments nested deeper
+// than the schema allows are left out, so they are never touched.
+func (r *orgChartResource) readLiveOrgChart(ctx context.Context, rootDepartmentID string) (*orgChartLive, *diag.ErrorDiagnostic) {
+	response, err := common.DepartmentChildrenListAPI(ctx, r.client, rootDepartmentID, common.OPEN_DEPARTMENT_ID, true)
+	if err != nil {
+		errDiag := diag.NewErrorDiagnostic("API Error Listing Department Children", err.Error())
+		return nil, &errDiag
+	}
+
+	departmentIDs := map[string]string{rootDepartmentID: ""}
+	for _, department := range response.Data.Items {
+		departmentIDs[department.OpenDepartmentID] = department.DepartmentID
+	}
+
+	live := &orgChartLive{
+		departments: map[string]common.Department{},
+		parentIDs:   map[string]string{},
+		depths:      map[string]int{},
+		order:       []string{},
+	}
+	for _, department := range response.Data.Items {
+		live.departments[department.DepartmentID] = department
+		live.parentIDs[department.DepartmentID] = departmentIDs[department.ParentDepartmentID]
+		live.order = append(live.order, department.DepartmentID)
+	}
+
+	var depth func(departmentID string) int
+	depth = func(departmentID string) int {
+		if departmentID == "" {
+			return 0
+		}
+		if _, ok := live.depths[departmentID]; !ok {
+			live.depths[departmentID] = depth(live.parentIDs[departmentID]) + 1
+		}
+		return live.depths[departmentID]
+	}
+	for _, departmentID := range live.order {
+		if depth(departmentID) > orgChartMaxDepth {
+			delete(live.departments, departmentID)
+		}
+	}
+	live.order = slices.DeleteFunc(live.order, func(departmentID string) bool {
+		return live.depths[departmentID] > orgChartMaxDepth
+	})
+
+	return live, nil
+}
+
+// planOrgChart works out what happens to each department of the tree, in the order of
+// the tree, and which live departments are deleted, children first.
+func planOrgChart(entries []orgChartEntry, live *orgChartLive) ([]orgChartStep, []common.Department) {
+	desired := map[string]bool{}
+	steps := []orgChartStep{}
+	for _, entry := range entries {
+		departmentID := entry.department.DepartmentID.ValueString()
+		desired[departmentID] = true
+
+		step := orgChartStep{
+			department: entry.department,
+			parentID:   entry.parentID,
+		}
+		if department, ok := live.departments[departmentID]; ok {
+			step.live = &department
+			step.move = live.parentIDs[departmentID] != entry.parentID
+			step.rename = department.Name != entry.department.Name.ValueString()
+			step.update = orgChartNeedsUpdate(entry.department, department)
+		}
+		steps = append(steps, step)
+	}
+
+	deletes := []common.Department{}
+	for _, departmentID := range live.order {
+		if !desired[departmentID] {
+			deletes = append(deletes, live.departments[departmentID])
+		}
+	}
+	slices.SortStableFunc(deletes, func(a, b common.Department) int {
+		return live.depths[b.DepartmentID] - live.depths[a.DepartmentID]
+	})
+
+	return steps, deletes
+}
+
+func describeOrgChart(steps []orgChartStep, deletes []common.Department, live *orgChartLive) []string {
+	parentName := func(departmentID string) string {
+		if departmentID == "" {
+			return "root"
+		}
+		return departmentID
+	}
+
+	changes := []string{}
+	for _, step := range steps {
+		departmentID := step.department.DepartmentID.ValueString()
+		if step.live == nil {
+			changes = append(changes, fmt.Sprintf("create %s (%s) under %s", departmentID, step.department.Name.ValueString(), parentName(step.parentID)))
+			continue
+		}
+		if step.move {
+			changes = append(changes, fmt.Sprintf("move %s from %s to %s", departmentID, parentName(live.parentIDs[departmentID]), parentName(step.parentID)))
+		}
+		if step.rename {
+			changes = append(changes, fmt.Sprintf("rename %s from %s to %s", departmentID, step.live.Name, step.department.Name.ValueString()))
+		}
+	}
+	for _, department := range deletes {
+		changes = append(changes, fmt.Sprintf("delete %s (%s)", department.DepartmentID, department.Name))
+	}
+
+	return changes
+}
+
+// orgChartNeedsUpdate reports whether an attribute other than the name and the parent differs, unset attributes are not managed.
+func orgChartNeedsUpdate(department *orgChartDepartment, live common.Department) bool {
+	if !department.I18nName.IsNull() && orgChartI18nName(department.I18nName) != live.I18nName {
+		return true
+	}
+	if !department.Leaders.IsNull() && !slices.Equal(orgChartLeaders(department.Leaders), live.Leaders) {
+		return true
+	}
+	return !department.Order.IsNull() && department.Order.ValueString() != live.Order
+}
+
+// buildOrgChart rebuilds the tree under parentID from the live departments. Departments keep
+// their order from the current tree, new ones are appended, and unset attributes stay unset.
+func buildOrgChart(live *orgChartLive, parentID string, current []*orgChartDepartment, templates map[string]*orgChartDepartment, depth int) []*orgChartDepartment {
+	departmentIDs := []string{}
+	for _, department := range current {
+		departmentID := department.DepartmentID.ValueString()
+		if _, ok := live.departments[departmentID]; ok && live.parentIDs[departmentID] == parentID {
+			departmentIDs = append(departmentIDs, departmentID)
+		}
+	}
+	for _, departmentID := range live.order {
+		if live.parentIDs[departmentID] == parentID && !slices.Contains(departmentIDs, departmentID) {
+			departmentIDs = append(departmentIDs, departmentID)
+		}
+	}
+
+	departments := []*orgChartDepartment{}
+	for _, departmentID := range departmentIDs {
+		remote := live.departments[departmentID]
+		department := &orgChartDepartment{
+			DepartmentID:     types.StringValue(departmentID),
+			Name:             types.StringValue(remote.Name),
+			I18nName:         types.ObjectNull(orgChartI18nNameAttrTypes),
+			Leaders:          types.ListNull(types.ObjectType{AttrTypes: orgChartLeaderAttrTypes}),
+			Order:            types.StringNull(),
+			OpenDepartmentID: types.StringValue(remote.OpenDepartmentID),
+			ChildrenNull:     true,
+		}
+
+		children := []*orgChartDepartment{}
+		if template, ok := templates[departmentID]; ok {
+			if !template.I18nName.IsNull() {
+				attributes := template.I18nName.Attributes()
+				department.I18nName = types.ObjectValueMust(orgChartI18nNameAttrTypes, map[string]attr.Value{
+					"zh_cn": optionalStringValue(attributes["zh_cn"].(types.String), remote.I18nName.ZhCn),
+					"ja_jp": optionalStringValue(attributes["ja_jp"].(types.String), remote.I18nName.JaJp),
+					"en_us": optionalStringValue(attributes["en_us"].(types.String), remote.I18nName.EnUs),
+				})
+			}
+			if !template.Leaders.IsNull() {
+				department.Leaders = orgChartLeadersValue(remote.Leaders)
+			}
+			if !template.Order.IsNull() {
+				department.Order = types.StringValue(remote.Order)
+			}
+			department.ChildrenNull = template.ChildrenNull
+			children = template.Children
+		}
+
+		if depth < orgChartMaxDepth {
+			department.Children = buildOrgChart(live, departmentID, children, templates, depth+1)
+		}
+		departments = append(departments, department)
+	}
+
+	return departments
+}
+
+// flattenOrgChart lists the departments of the tree, every parent before its children.
+func flattenOrgChart(departments []*orgChartDepartment, parentID string) []orgChartEntry {
+	entries := []orgChartEntry{}
+	for _, department := range departments {
+		entries = append(entries, orgChartEntry{department: department, parentID: parentID})
+		entries = append(entries, flattenOrgChart(department.Children, department.DepartmentID.ValueString())...)
+	}
+	return entries
+}
+
+// orgChartKnown reports whether the shape of the tree and the department IDs and names are known.
+func orgChartKnown(departments types.List) bool {
+	if departments.IsUnknown() {
+		return false
+	}
+	for _, element := range departments.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsUnknown() {
+			return false
+		}
+		attributes := object.Attributes()
+		if attributes["department_id"].IsUnknown() || attributes["name"].IsUnknown() {
+			return false
+		}
+		if children, ok := attributes["children"].(types.List); ok && !orgChartKnown(children) {
+			return false
+		}
+	}
+	return true
+}
+
+func orgChartDepartmentsFromList(departments types.List) []*orgChartDepartment {
+	result := []*orgChartDepartment{}
+	for _, element := range departments.Elements() {
+		attributes := element.(types.Object).Attributes()
+		department := &orgChartDepartment{
+			DepartmentID:     attributes["department_id"].(types.String),
+			Name:             attributes["name"].(types.String),
+			I18nName:         attributes["i18n_name"].(types.Object),
+			Leaders:          attributes["leaders"].(types.List),
+			Order:            attributes["order"].(types.String),
+			OpenDepartmentID: attributes["open_department_id"].(types.String),
+			ChildrenNull:     true,
+		}
+		if children, ok := attributes["children"].(types.List); ok {
+			department.Children = orgChartDepartmentsFromList(children)
+			department.ChildrenNull = children.IsNull()
+		}
+		result = append(result, department)
+	}
+	return result
+}
+
+func orgChartDepartmentsToList(departments []*orgChartDepartment, null bool, depth int) types.List {
+	attrTypes := orgChartDepartmentAttrTypes(depth)
+	if null && len(departments) == 0 {
+		return types.ListNull(types.ObjectType{AttrTypes: attrTypes})
+	}
+
+	elements := []attr.Value{}
+	for _, department := range departments {
+		attributes := map[string]attr.Value{
+			"department_id":      department.DepartmentID,
+			"name":               department.Name,
+			"i18n_name":          department.I18nName,
+			"leaders":            department.Leaders,
+			"order":              department.Order,
+			"open_department_id": department.OpenDepartmentID,
+		}
+		if depth < orgChartMaxDepth {
+			attributes["children"] = orgChartDepartmentsToList(department.Children, department.ChildrenNull, depth+1)
+		}
+		elements = append(elements, types.ObjectValueMust(attrTypes, attributes))
+	}
+	return types.ListValueMust(types.ObjectType{AttrTypes: attrTypes}, elements)
+}
+
+func orgChartDepartmentAttrTypes(depth int) map[string]attr.Type {
+	attrTypes := map[string]attr.Type{
+		"department_id":      types.StringType,
+		"name":               types.StringType,
+		"i18n_name":          types.ObjectType{AttrTypes: orgChartI18nNameAttrTypes},
+		"leaders":            types.ListType{ElemType: types.ObjectType{AttrTypes: orgChartLeaderAttrTypes}},
+		"order":              types.StringType,
+		"open_department_id": types.StringType,
+	}
+	if depth < orgChartMaxDepth {
+		attrTypes["children"] = types.ListType{ElemType: types.ObjectType{AttrTypes: orgChartDepartmentAttrTypes(depth + 1)}}
+	}
+	return attrTypes
+}
+
+func orgChartI18nName(i18nName types.Object) common.I18nName {
+	if i18nName.IsNull() || i18nName.IsUnknown() {
+		return common.I18nName{}
+	}
+	attributes := i18nName.Attributes()
+	return common.I18nName{
+		ZhCn: attributes["zh_cn"].(types.String).ValueString(),
+		JaJp: attributes["ja_jp"].(types.String).ValueString(),
+		EnUs: attributes["en_us"].(types.String).ValueString(),
+	}
+}
+
+func orgChartLeaders(leaders types.List) []common.DepartmentLeader {
+	result := []common.DepartmentLeader{}
+	for _, element := range leaders.Elements() {
+		attributes := element.(types.Object).Attributes()
+		result = append(result, common.DepartmentLeader{
+			LeaderType: attributes["leader_type"].(types.Int64).ValueInt64(),
+			LeaderID:   attributes["leader_id"].(types.String).ValueString(),
+		})
+	}
+	return result
+}
+
+func orgChartLeadersValue(leaders []common.DepartmentLeader) types.List {
+	elements := []attr.Value{}
+	for _, leader := range leaders {
+		elements = append(elements, types.ObjectValueMust(orgChartLeaderAttrTypes, map[string]attr.Value{
+			"leader_type": types.Int64Value(leader.LeaderType),
+			"leader_id":   types.StringValue(leader.LeaderID),
+		}))
+	}
+	return types.ListValueMust(types.ObjectType{AttrTypes: orgChartLeaderAttrTypes}, elements)
+}
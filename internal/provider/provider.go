@@ -109,11 +109,27 @@ func (p *LarkProvider) Resources(ctx context.Context) []func() resource.Resource
 		NewDepartmentResource,
 		NewDocsSpaceFolderResource,
 		NewGroupChatResource,
+		NewGroupChatAnnouncementResource,
 		NewGroupChatMemberResource,
+		NewGroupChatMemberBindingResource,
+		NewGroupChatMenuResource,
+		NewGroupChatModerationResource,
+		NewGroupChatTabsResource,
+		NewGroupChatTopNoticeResource,
+		NewImImageResource,
+		NewImMessageResource,
+		NewJobFamilyResource,
+		NewJobLevelResource,
+		NewOrgChartResource,
 		NewRoleResource,
 		NewRoleMemberResource,
+		NewRoleMemberBindingResource,
+		NewUnitResource,
+		NewUnitDepartmentBindingResource,
+		NewUserResource,
 		NewUserGroupResource,
 		NewUserGroupMemberResource,
+		NewUserGroupMemberBindingResource,
 		NewWorkforceTypeResource,
 	}
 }
@@ -124,6 +140,17 @@ func (p *LarkProvider) EphemeralResources(ctx context.Context) []func() ephemera
 
 func (p *LarkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewDepartmentDataSource,
+		NewDepartmentUsersDataSource,
+		NewDepartmentsDataSource,
+		NewGroupChatDataSource,
+		NewGroupChatLinkDataSource,
+		NewGroupChatMembersDataSource,
+		NewGroupChatsDataSource,
+		NewJobTitleDataSource,
+		NewJobTitlesDataSource,
+		NewUnitDataSource,
+		NewUserDataSource,
 		NewUserByEmailDataSource,
 		NewUserByIDDataSource,
 	}
@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	local_validator "github.com/aganisatria/terraform-provider-lark/internal/validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &roleMemberBindingResource{}
+var _ resource.ResourceWithConfigValidators = &roleMemberBindingResource{}
+var _ resource.ResourceWithImportState = &roleMemberBindingResource{}
+
+func NewRoleMemberBindingResource() resource.Resource {
+	return &roleMemberBindingResource{}
+}
+
+// roleMemberBindingResource defines the resource implementation.
+// Unlike lark_role_member, it only manages a single member and leaves the other members alone.
+type roleMemberBindingResource struct {
+	client *common.LarkClient
+}
+
+// roleMemberBindingResourceModel describes the resource data model.
+// fields that need to be configured by user.
+type roleMemberBindingResourceModel struct {
+	BaseResourceModel
+	RoleID   types.String `tfsdk:"role_id"`
+	MemberID types.String `tfsdk:"member_id"`
+}
+
+func (r *roleMemberBindingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_member_binding"
+}
+
+func (r *roleMemberBindingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"role_id": schema.StringAttribute{
+			Description:         "Unique identity of the role, unique under a single tenant",
+			MarkdownDescription: "Unique identity of the role, unique under a single tenant",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"member_id": schema.StringAttribute{
+			Description:         "Role member added to the role (OpenID of the user)",
+			MarkdownDescription: "Role member added to the role (OpenID of the user)",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Manages a single role member in Lark without affecting the other members",
+		MarkdownDescription: "Manages a single role member in Lark without affecting the other members",
+		Attributes:          attributes,
+	}
+}
+
+func (r *roleMemberBindingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *roleMemberBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data roleMemberBindingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := common.RoleMemberAddAPI(ctx, r.client, data.RoleID.ValueString(), common.RoleMemberCreateRequest{
+		Members: []string{data.MemberID.ValueString()},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Adding Role Member", err.Error())
+		return
+	}
+
+	// Reason 1 means success and reason 4 means the user is already a member of the role.
+	for _, member := range response.Data.Results {
+		if member.Reason != 1 && member.Reason != 4 {
+			resp.Diagnostics.AddError(
+				"API Error Adding Role Member",
+				fmt.Sprintf("Failed to add member %s to role %s: %d", member.UserID, data.RoleID.ValueString(), member.Reason),
+			)
+			return
+		}
+	}
+
+	data.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.ROLE_MEMBER_BINDING, data.RoleID.ValueString()+"_"+data.MemberID.ValueString()))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *roleMemberBindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state roleMemberBindingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := common.RoleMemberGetAPI(ctx, r.client, state.RoleID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Getting Role Member", err.Error())
+		return
+	}
+
+	dbMembersIDs := []string{}
+	for _, member := range response.Data.Members {
+		dbMembersIDs = append(dbMembersIDs, member.UserID)
+	}
+
+	// The member was removed outside of terraform, let terraform add it back.
+	if !slices.Contains(dbMembersIDs, state.MemberID.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if state.Id.IsNull() || state.Id.ValueString() == "" {
+		state.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.ROLE_MEMBER_BINDING, state.RoleID.ValueString()+"_"+state.MemberID.ValueString()))
+	}
+	state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called since every configurable attribute requires replacement.
+func (r *roleMemberBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan roleMemberBindingResourceModel
+	var state roleMemberBindingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *roleMemberBindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state roleMemberBindingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := common.RoleMemberDeleteAPI(ctx, r.client, state.RoleID.ValueString(), common.RoleMemberDeleteRequest{
+		Members: []string{state.MemberID.ValueString()},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Removing Role Member", err.Error())
+		return
+	}
+
+	// Reason 1 means success and reason 5 means the user is no longer a member of the role.
+	for _, member := range response.Data.Results {
+		if member.Reason != 1 && member.Reason != 5 {
+			resp.Diagnostics.AddError(
+				"API Error Removing Role Member",
+				fmt.Sprintf("Failed to remove member %s from role %s: %d", member.UserID, state.RoleID.ValueString(), member.Reason),
+			)
+			return
+		}
+	}
+}
+
+// ImportState imports the binding using "<role_id>:<member_id>".
+func (r *roleMemberBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := common.SplitCompositeID(req.ID, 2)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <role_id>:<member_id>, %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member_id"), parts[1])...)
+}
+
+func (r *roleMemberBindingResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	// There is a mini gap that client not yet initialized before terraform plan is executed, so we need to check if the client is nil
+	if r.client == nil {
+		return []resource.ConfigValidator{}
+	}
+	return []resource.ConfigValidator{
+		local_validator.NewUserIDValidator("member_id", false, false, common.OPEN_ID, r.client),
+	}
+}
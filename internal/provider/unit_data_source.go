@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &UnitDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &UnitDataSource{}
+
+func NewUnitDataSource() datasource.DataSource {
+	return &UnitDataSource{}
+}
+
+// UnitDataSource defines the data source implementation.
+type UnitDataSource struct {
+	client *common.LarkClient
+}
+
+// UnitDataSourceModel describes the data source data model.
+type UnitDataSourceModel struct {
+	BaseResourceModel
+	UnitID        types.String   `tfsdk:"unit_id"`
+	Name          types.String   `tfsdk:"name"`
+	UnitType      types.String   `tfsdk:"unit_type"`
+	DepartmentIDs []types.String `tfsdk:"department_ids"`
+}
+
+func (d *UnitDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unit"
+}
+
+func (d *UnitDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"unit_id": schema.StringAttribute{
+			Description:         "Unit ID of the unit to look up. Exactly one of unit_id and name must be set.",
+			MarkdownDescription: "Unit ID of the unit to look up. Exactly one of `unit_id` and `name` must be set.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			Description:         "Exact name of the unit to look up. Exactly one of unit_id and name must be set.",
+			MarkdownDescription: "Exact name of the unit to look up. Exactly one of `unit_id` and `name` must be set.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"unit_type": schema.StringAttribute{
+			Description:         "Unit type.",
+			MarkdownDescription: "Unit type.",
+			Computed:            true,
+		},
+		"department_ids": schema.ListAttribute{
+			Description:         "Open department IDs of the departments bound to the unit.",
+			MarkdownDescription: "Open department IDs of the departments bound to the unit.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Retrieve a unit in Lark by ID or by name",
+		MarkdownDescription: "Retrieve a unit in Lark by ID or by name",
+		Attributes:          attributes,
+	}
+}
+
+func (d *UnitDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UnitDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("unit_id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *UnitDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UnitDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var unit common.Unit
+	if !data.UnitID.IsNull() {
+		response, err := common.UnitGetAPI(ctx, d.client, data.UnitID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Reading Unit", err.Error())
+			return
+		}
+		unit = response.Data.Unit
+	} else {
+		response, err := common.UnitListAPI(ctx, d.client)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Listing Units", err.Error())
+			return
+		}
+
+		units := []common.Unit{}
+		for _, candidate := range response.Data.UnitList {
+			if candidate.Name == data.Name.ValueString() {
+				units = append(units, candidate)
+			}
+		}
+
+		if len(units) == 0 {
+			resp.Diagnostics.AddError("Unit Not Found", fmt.Sprintf("No unit named %s", data.Name.ValueString()))
+			return
+		}
+		if len(units) > 1 {
+			unitIDs := []string{}
+			for _, unit := range units {
+				unitIDs = append(unitIDs, unit.UnitID)
+			}
+			resp.Diagnostics.AddError(
+				"Ambiguous Unit Name",
+				fmt.Sprintf("%d units are named %s: %s, use unit_id instead", len(units), data.Name.ValueString(), strings.Join(unitIDs, ", ")),
+			)
+			return
+		}
+		unit = units[0]
+	}
+
+	departments, err := common.UnitDepartmentListAPI(ctx, d.client, unit.UnitID, common.OPEN_DEPARTMENT_ID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Listing Unit Departments", err.Error())
+		return
+	}
+
+	data.UnitID = types.StringValue(unit.UnitID)
+	data.Name = types.StringValue(unit.Name)
+	data.UnitType = types.StringValue(unit.UnitType)
+	data.DepartmentIDs = []types.String{}
+	for _, department := range departments.Data.DepartmentList {
+		data.DepartmentIDs = append(data.DepartmentIDs, types.StringValue(department.DepartmentID))
+	}
+
+	data.Id = types.StringValue(common.ConstructID(common.DATA_SOURCE, common.UNIT, unit.UnitID))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
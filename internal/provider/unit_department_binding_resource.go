@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &unitDepartmentBindingResource{}
+var _ resource.ResourceWithImportState = &unitDepartmentBindingResource{}
+
+func NewUnitDepartmentBindingResource() resource.Resource {
+	return &unitDepartmentBindingResource{}
+}
+
+// unitDepartmentBindingResource defines the resource implementation.
+// It only manages a single department of the unit and leaves the other departments alone.
+type unitDepartmentBindingResource struct {
+	client *common.LarkClient
+}
+
+// unitDepartmentBindingResourceModel describes the resource data model.
+// fields that need to be configured by user.
+type unitDepartmentBindingResourceModel struct {
+	BaseResourceModel
+	UnitID           types.String `tfsdk:"unit_id"`
+	DepartmentID     types.String `tfsdk:"department_id"`
+	DepartmentIDType types.String `tfsdk:"department_id_type"`
+}
+
+func (r *unitDepartmentBindingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unit_department_binding"
+}
+
+func (r *unitDepartmentBindingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"unit_id": schema.StringAttribute{
+			Description:         "Unit ID of the unit the department is bound to.",
+			MarkdownDescription: "Unit ID of the unit the department is bound to.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"department_id": schema.StringAttribute{
+			Description:         "ID of the department bound to the unit, of the type set by department_id_type.",
+			MarkdownDescription: "ID of the department bound to the unit, of the type set by `department_id_type`.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"department_id_type": schema.StringAttribute{
+			Description:         "Type of department_id, one of open_department_id and department_id. Defaults to open_department_id.",
+			MarkdownDescription: "Type of `department_id`, one of `open_department_id` and `department_id`. Defaults to `open_department_id`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(string(common.OPEN_DEPARTMENT_ID)),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.OneOf(string(common.OPEN_DEPARTMENT_ID), string(common.DEPARTMENT_ID)),
+			},
+		},
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Binds a single department to a unit in Lark without affecting the other departments of the unit",
+		MarkdownDescription: "Binds a single department to a unit in Lark without affecting the other departments of the unit",
+		Attributes:          attributes,
+	}
+}
+
+func (r *unitDepartmentBindingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *unitDepartmentBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data unitDepartmentBindingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := common.UnitBindDepartmentAPI(ctx, r.client, unitDepartmentRequest(data))
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Binding Unit Department", err.Error())
+		return
+	}
+
+	data.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.UNIT_DEPARTMENT_BINDING, data.UnitID.ValueString()+"_"+data.DepartmentID.ValueString()))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *unitDepartmentBindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state unitDepartmentBindingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.DepartmentIDType.IsNull() {
+		state.DepartmentIDType = types.StringValue(string(common.OPEN_DEPARTMENT_ID))
+	}
+
+	response, err := common.UnitDepartmentListAPI(ctx, r.client, state.UnitID.ValueString(), common.DepartmentIDType(state.DepartmentIDType.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Listing Unit Departments", err.Error())
+		return
+	}
+
+	// The department was unbound outside of terraform, let terraform bind it again.
+	if !slices.ContainsFunc(response.Data.DepartmentList, func(department common.UnitDepartment) bool {
+		return department.DepartmentID == state.DepartmentID.ValueString()
+	}) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if state.Id.IsNull() || state.Id.ValueString() == "" {
+		state.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.UNIT_DEPARTMENT_BINDING, state.UnitID.ValueString()+"_"+state.DepartmentID.ValueString()))
+	}
+	state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called since every configurable attribute requires replacement.
+func (r *unitDepartmentBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan unitDepartmentBindingResourceModel
+	var state unitDepartmentBindingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *unitDepartmentBindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state unitDepartmentBindingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := common.UnitUnbindDepartmentAPI(ctx, r.client, unitDepartmentRequest(state))
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Unbinding Unit Department", err.Error())
+		return
+	}
+}
+
+// ImportState imports the binding using "<unit_id>:<department_id>", the department_id being an open department ID.
+func (r *unitDepartmentBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := common.SplitCompositeID(req.ID, 2)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <unit_id>:<department_id>, %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("unit_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("department_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("department_id_type"), string(common.OPEN_DEPARTMENT_ID))...)
+}
+
+func unitDepartmentRequest(data unitDepartmentBindingResourceModel) common.UnitDepartmentRequest {
+	return common.UnitDepartmentRequest{
+		UnitID:           data.UnitID.ValueString(),
+		DepartmentID:     data.DepartmentID.ValueString(),
+		DepartmentIDType: common.DepartmentIDType(data.DepartmentIDType.ValueString()),
+	}
+}
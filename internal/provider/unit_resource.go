@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &unitResource{}
+var _ resource.ResourceWithImportState = &unitResource{}
+
+func NewUnitResource() resource.Resource {
+	return &unitResource{}
+}
+
+// unitResource defines the resource implementation.
+type unitResource struct {
+	client *common.LarkClient
+}
+
+// unitResourceModel describes the resource data model.
+// fields that need to be configured by user.
+type unitResourceModel struct {
+	BaseResourceModel
+	UnitID   types.String `tfsdk:"unit_id"`
+	Name     types.String `tfsdk:"name"`
+	UnitType types.String `tfsdk:"unit_type"`
+}
+
+func (r *unitResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unit"
+}
+
+func (r *unitResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"unit_id": schema.StringAttribute{
+			Description:         "Custom unit ID, generated by Lark when not set. Changing it recreates the unit.",
+			MarkdownDescription: "Custom unit ID, generated by Lark when not set. Changing it recreates the unit.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description:         "Unit name.",
+			MarkdownDescription: "Unit name.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 100),
+			},
+		},
+		"unit_type": schema.StringAttribute{
+			Description:         "Unit type, for example subsidiary or business unit. Changing it recreates the unit.",
+			MarkdownDescription: "Unit type, for example `subsidiary` or `business unit`. Changing it recreates the unit.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 100),
+			},
+		},
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Manages a unit in Lark",
+		MarkdownDescription: "Manages a unit in Lark",
+		Attributes:          attributes,
+	}
+}
+
+func (r *unitResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *unitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data unitResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := common.UnitCreateRequest{
+		BaseUnit: common.BaseUnit{
+			Name:     data.Name.ValueString(),
+			UnitType: data.UnitType.ValueString(),
+		},
+	}
+	if !data.UnitID.IsUnknown() && !data.UnitID.IsNull() {
+		request.UnitID = data.UnitID.ValueString()
+	}
+
+	response, err := common.UnitCreateAPI(ctx, r.client, request)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Creating Unit", err.Error())
+		return
+	}
+
+	data.UnitID = types.StringValue(response.Data.UnitID)
+	data.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.UNIT, response.Data.UnitID))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *unitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state unitResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := common.UnitGetAPI(ctx, r.client, state.UnitID.ValueString())
+	if common.IsAPIErrorCode(err, common.UNIT_NOT_FOUND_CODE) {
+		resp.Diagnostics.AddWarning(
+			"Unit Deleted",
+			fmt.Sprintf("Unit %s has been deleted outside of Terraform, removing it from the state.", state.UnitID.ValueString()),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading Unit", err.Error())
+		return
+	}
+
+	unit := response.Data.Unit
+	state.UnitID = types.StringValue(unit.UnitID)
+	state.Name = types.StringValue(unit.Name)
+	state.UnitType = types.StringValue(unit.UnitType)
+
+	if state.Id.IsNull() || state.Id.ValueString() == "" {
+		state.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.UNIT, unit.UnitID))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only renames the unit, every other configurable attribute requires replacement.
+func (r *unitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan unitResourceModel
+	var state unitResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Name.Equal(state.Name) {
+		_, err := common.UnitUpdateAPI(ctx, r.client, state.UnitID.ValueString(), common.UnitUpdateRequest{
+			Name: plan.Name.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Updating Unit", err.Error())
+			return
+		}
+	}
+
+	plan.Id = state.Id
+	plan.UnitID = state.UnitID
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *unitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state unitResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := common.UnitDeleteAPI(ctx, r.client, state.UnitID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Deleting Unit", err.Error())
+		return
+	}
+}
+
+// ImportState imports the unit using its unit_id.
+func (r *unitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("unit_id"), req, resp)
+}
@@ -0,0 +1,479 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &UserDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &UserDataSource{}
+
+func NewUserDataSource() datasource.DataSource {
+	return &UserDataSource{}
+}
+
+// UserDataSource defines the data source implementation.
+type UserDataSource struct {
+	client *common.LarkClient
+}
+
+type UserAvatar struct {
+	Avatar72     types.String `tfsdk:"avatar_72"`
+	Avatar240    types.String `tfsdk:"avatar_240"`
+	Avatar640    types.String `tfsdk:"avatar_640"`
+	AvatarOrigin types.String `tfsdk:"avatar_origin"`
+}
+
+type UserStatus struct {
+	IsFrozen    types.Bool `tfsdk:"is_frozen"`
+	IsResigned  types.Bool `tfsdk:"is_resigned"`
+	IsActivated types.Bool `tfsdk:"is_activated"`
+	IsExited    types.Bool `tfsdk:"is_exited"`
+	IsUnjoin    types.Bool `tfsdk:"is_unjoin"`
+}
+
+type UserOrder struct {
+	DepartmentID    types.String `tfsdk:"department_id"`
+	UserOrder       types.Int64  `tfsdk:"user_order"`
+	DepartmentOrder types.Int64  `tfsdk:"department_order"`
+	IsPrimaryDept   types.Bool   `tfsdk:"is_primary_dept"`
+}
+
+type UserCustomAttr struct {
+	ID            types.String `tfsdk:"id"`
+	Type          types.String `tfsdk:"type"`
+	Text          types.String `tfsdk:"text"`
+	Url           types.String `tfsdk:"url"`
+	PCUrl         types.String `tfsdk:"pc_url"`
+	OptionID      types.String `tfsdk:"option_id"`
+	OptionValue   types.String `tfsdk:"option_value"`
+	Name          types.String `tfsdk:"name"`
+	PictureUrl    types.String `tfsdk:"picture_url"`
+	GenericUserID types.String `tfsdk:"generic_user_id"`
+}
+
+type UserDepartmentPath struct {
+	DepartmentID      types.String   `tfsdk:"department_id"`
+	DepartmentName    types.String   `tfsdk:"department_name"`
+	PathDepartmentIDs []types.String `tfsdk:"path_department_ids"`
+	PathName          types.String   `tfsdk:"path_name"`
+}
+
+// UserDataSourceModel describes the data source data model.
+type UserDataSourceModel struct {
+	BaseResourceModel
+	OpenID          types.String         `tfsdk:"open_id"`
+	UserID          types.String         `tfsdk:"user_id"`
+	UnionID         types.String         `tfsdk:"union_id"`
+	Name            types.String         `tfsdk:"name"`
+	EnName          types.String         `tfsdk:"en_name"`
+	Email           types.String         `tfsdk:"email"`
+	Mobile          types.String         `tfsdk:"mobile"`
+	Avatar          *UserAvatar          `tfsdk:"avatar"`
+	Status          *UserStatus          `tfsdk:"status"`
+	DepartmentIDs   []types.String       `tfsdk:"department_ids"`
+	Orders          []UserOrder          `tfsdk:"orders"`
+	LeaderUserID    types.String         `tfsdk:"leader_user_id"`
+	EmployeeType    types.Int64          `tfsdk:"employee_type"`
+	JobTitle        types.String         `tfsdk:"job_title"`
+	City            types.String         `tfsdk:"city"`
+	CustomAttrs     []UserCustomAttr     `tfsdk:"custom_attrs"`
+	DepartmentPaths []UserDepartmentPath `tfsdk:"department_paths"`
+}
+
+func (d *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *UserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"open_id": schema.StringAttribute{
+			Description:         "Open ID of the user to look up. Exactly one of open_id, user_id and union_id must be set.",
+			MarkdownDescription: "Open ID of the user to look up. Exactly one of `open_id`, `user_id` and `union_id` must be set.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"user_id": schema.StringAttribute{
+			Description:         "User ID of the user to look up. Exactly one of open_id, user_id and union_id must be set.",
+			MarkdownDescription: "User ID of the user to look up. Exactly one of `open_id`, `user_id` and `union_id` must be set.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"union_id": schema.StringAttribute{
+			Description:         "Union ID of the user to look up. Exactly one of open_id, user_id and union_id must be set.",
+			MarkdownDescription: "Union ID of the user to look up. Exactly one of `open_id`, `user_id` and `union_id` must be set.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			Description:         "Name of the user.",
+			MarkdownDescription: "Name of the user.",
+			Computed:            true,
+		},
+		"en_name": schema.StringAttribute{
+			Description:         "English name of the user.",
+			MarkdownDescription: "English name of the user.",
+			Computed:            true,
+		},
+		"email": schema.StringAttribute{
+			Description:         "Email of the user.",
+			MarkdownDescription: "Email of the user.",
+			Computed:            true,
+		},
+		"mobile": schema.StringAttribute{
+			Description:         "Mobile number of the user.",
+			MarkdownDescription: "Mobile number of the user.",
+			Computed:            true,
+		},
+		"avatar": schema.SingleNestedAttribute{
+			Description:         "Avatar URLs of the user.",
+			MarkdownDescription: "Avatar URLs of the user.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"avatar_72": schema.StringAttribute{
+					Description:         "URL of the 72x72 avatar.",
+					MarkdownDescription: "URL of the 72x72 avatar.",
+					Computed:            true,
+				},
+				"avatar_240": schema.StringAttribute{
+					Description:         "URL of the 240x240 avatar.",
+					MarkdownDescription: "URL of the 240x240 avatar.",
+					Computed:            true,
+				},
+				"avatar_640": schema.StringAttribute{
+					Description:         "URL of the 640x640 avatar.",
+					MarkdownDescription: "URL of the 640x640 avatar.",
+					Computed:            true,
+				},
+				"avatar_origin": schema.StringAttribute{
+					Description:         "URL of the original avatar.",
+					MarkdownDescription: "URL of the original avatar.",
+					Computed:            true,
+				},
+			},
+		},
+		"status": schema.SingleNestedAttribute{
+			Description:         "Status of the user.",
+			MarkdownDescription: "Status of the user.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"is_frozen": schema.BoolAttribute{
+					Description:         "Whether the user is frozen.",
+					MarkdownDescription: "Whether the user is frozen.",
+					Computed:            true,
+				},
+				"is_resigned": schema.BoolAttribute{
+					Description:         "Whether the user has resigned.",
+					MarkdownDescription: "Whether the user has resigned.",
+					Computed:            true,
+				},
+				"is_activated": schema.BoolAttribute{
+					Description:         "Whether the user has activated their account.",
+					MarkdownDescription: "Whether the user has activated their account.",
+					Computed:            true,
+				},
+				"is_exited": schema.BoolAttribute{
+					Description:         "Whether the user has left the tenant on their own.",
+					MarkdownDescription: "Whether the user has left the tenant on their own.",
+					Computed:            true,
+				},
+				"is_unjoin": schema.BoolAttribute{
+					Description:         "Whether the user has not accepted the invitation yet.",
+					MarkdownDescription: "Whether the user has not accepted the invitation yet.",
+					Computed:            true,
+				},
+			},
+		},
+		"department_ids": schema.ListAttribute{
+			Description:         "Open department IDs of the departments of the user.",
+			MarkdownDescription: "Open department IDs of the departments of the user.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"orders": schema.ListNestedAttribute{
+			Description:         "Order of the user in each of their departments.",
+			MarkdownDescription: "Order of the user in each of their departments.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"department_id": schema.StringAttribute{
+						Description:         "Open department ID of the department.",
+						MarkdownDescription: "Open department ID of the department.",
+						Computed:            true,
+					},
+					"user_order": schema.Int64Attribute{
+						Description:         "Order of the user within the department.",
+						MarkdownDescription: "Order of the user within the department.",
+						Computed:            true,
+					},
+					"department_order": schema.Int64Attribute{
+						Description:         "Order of the department among the departments of the user.",
+						MarkdownDescription: "Order of the department among the departments of the user.",
+						Computed:            true,
+					},
+					"is_primary_dept": schema.BoolAttribute{
+						Description:         "Whether this is the primary department of the user.",
+						MarkdownDescription: "Whether this is the primary department of the user.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"leader_user_id": schema.StringAttribute{
+			Description:         "ID of the direct leader of the user, of the same type as the ID used for the lookup.",
+			MarkdownDescription: "ID of the direct leader of the user, of the same type as the ID used for the lookup.",
+			Computed:            true,
+		},
+		"employee_type": schema.Int64Attribute{
+			Description:         "Employee type, 1 regular, 2 intern, 3 outsourcing, 4 labor and 5 consultant, or the ID of a custom workforce type.",
+			MarkdownDescription: "Employee type, `1` regular, `2` intern, `3` outsourcing, `4` labor and `5` consultant, or the ID of a custom workforce type.",
+			Computed:            true,
+		},
+		"job_title": schema.StringAttribute{
+			Description:         "Job title of the user.",
+			MarkdownDescription: "Job title of the user.",
+			Computed:            true,
+		},
+		"city": schema.StringAttribute{
+			Description:         "Work city of the user.",
+			MarkdownDescription: "Work city of the user.",
+			Computed:            true,
+		},
+		"custom_attrs": schema.ListNestedAttribute{
+			Description:         "Custom attributes of the user.",
+			MarkdownDescription: "Custom attributes of the user.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description:         "ID of the custom attribute.",
+						MarkdownDescription: "ID of the custom attribute.",
+						Computed:            true,
+					},
+					"type": schema.StringAttribute{
+						Description:         "Type of the custom attribute, for example TEXT, HREF, ENUMERATION, PICTURE_ENUM or GENERIC_USER.",
+						MarkdownDescription: "Type of the custom attribute, for example `TEXT`, `HREF`, `ENUMERATION`, `PICTURE_ENUM` or `GENERIC_USER`.",
+						Computed:            true,
+					},
+					"text": schema.StringAttribute{
+						Description:         "Value of a TEXT attribute, or the title of a HREF attribute.",
+						MarkdownDescription: "Value of a `TEXT` attribute, or the title of a `HREF` attribute.",
+						Computed:            true,
+					},
+					"url": schema.StringAttribute{
+						Description:         "Link of a HREF attribute.",
+						MarkdownDescription: "Link of a `HREF` attribute.",
+						Computed:            true,
+					},
+					"pc_url": schema.StringAttribute{
+						Description:         "Desktop link of a HREF attribute.",
+						MarkdownDescription: "Desktop link of a `HREF` attribute.",
+						Computed:            true,
+					},
+					"option_id": schema.StringAttribute{
+						Description:         "Selected option of an ENUMERATION or PICTURE_ENUM attribute.",
+						MarkdownDescription: "Selected option of an `ENUMERATION` or `PICTURE_ENUM` attribute.",
+						Computed:            true,
+					},
+					"option_value": schema.StringAttribute{
+						Description:         "Value of the selected option.",
+						MarkdownDescription: "Value of the selected option.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						Description:         "Name of the selected option.",
+						MarkdownDescription: "Name of the selected option.",
+						Computed:            true,
+					},
+					"picture_url": schema.StringAttribute{
+						Description:         "Picture URL of a PICTURE_ENUM attribute.",
+						MarkdownDescription: "Picture URL of a `PICTURE_ENUM` attribute.",
+						Computed:            true,
+					},
+					"generic_user_id": schema.StringAttribute{
+						Description:         "ID of the user referenced by a GENERIC_USER attribute.",
+						MarkdownDescription: "ID of the user referenced by a `GENERIC_USER` attribute.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"department_paths": schema.ListNestedAttribute{
+			Description:         "Path from the root to each department of the user. Lark only returns it to apps allowed to read department paths.",
+			MarkdownDescription: "Path from the root to each department of the user. Lark only returns it to apps allowed to read department paths.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"department_id": schema.StringAttribute{
+						Description:         "Open department ID of the department.",
+						MarkdownDescription: "Open department ID of the department.",
+						Computed:            true,
+					},
+					"department_name": schema.StringAttribute{
+						Description:         "Name of the department.",
+						MarkdownDescription: "Name of the department.",
+						Computed:            true,
+					},
+					"path_department_ids": schema.ListAttribute{
+						Description:         "Open department IDs from the root to the department.",
+						MarkdownDescription: "Open department IDs from the root to the department.",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+					"path_name": schema.StringAttribute{
+						Description:         "Names of the departments from the root to the department.",
+						MarkdownDescription: "Names of the departments from the root to the department.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Retrieve the full profile of a user in Lark",
+		MarkdownDescription: "Retrieve the full profile of a user in Lark",
+		Attributes:          attributes,
+	}
+}
+
+func (d *UserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UserDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("open_id"),
+			path.MatchRoot("user_id"),
+			path.MatchRoot("union_id"),
+		),
+	}
+}
+
+func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lookupID, lookupIDType := data.OpenID.ValueString(), common.OPEN_ID
+	if !data.UserID.IsNull() {
+		lookupID, lookupIDType = data.UserID.ValueString(), common.USER_ID
+	}
+	if !data.UnionID.IsNull() {
+		lookupID, lookupIDType = data.UnionID.ValueString(), common.UNION_ID
+	}
+
+	response, err := common.UserGetAPI(ctx, d.client, lookupID, lookupIDType)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading User", err.Error())
+		return
+	}
+	user := response.Data.User
+
+	data.OpenID = types.StringValue(user.OpenID)
+	data.UserID = types.StringValue(user.UserID)
+	data.UnionID = types.StringValue(user.UnionID)
+	data.Name = types.StringValue(user.Name)
+	data.EnName = types.StringValue(user.EnName)
+	data.Email = types.StringValue(user.Email)
+	data.Mobile = types.StringValue(user.Mobile)
+	data.Avatar = &UserAvatar{
+		Avatar72:     types.StringValue(user.Avatar.Avatar72),
+		Avatar240:    types.StringValue(user.Avatar.Avatar240),
+		Avatar640:    types.StringValue(user.Avatar.Avatar640),
+		AvatarOrigin: types.StringValue(user.Avatar.AvatarOrigin),
+	}
+	data.Status = &UserStatus{
+		IsFrozen:    types.BoolValue(user.Status.IsFrozen),
+		IsResigned:  types.BoolValue(user.Status.IsResigned),
+		IsActivated: types.BoolValue(user.Status.IsActivated),
+		IsExited:    types.BoolValue(user.Status.IsExited),
+		IsUnjoin:    types.BoolValue(user.Status.IsUnjoin),
+	}
+	data.DepartmentIDs = common.StringsToStringValues(user.DepartmentIDs)
+	data.LeaderUserID = types.StringValue(user.LeaderUserID)
+	data.EmployeeType = types.Int64Value(int64(user.EmployeeType))
+	data.JobTitle = types.StringValue(user.JobTitle)
+	data.City = types.StringValue(user.City)
+
+	data.Orders = []UserOrder{}
+	for _, order := range user.Orders {
+		data.Orders = append(data.Orders, UserOrder{
+			DepartmentID:    types.StringValue(order.DepartmentID),
+			UserOrder:       types.Int64Value(int64(order.UserOrder)),
+			DepartmentOrder: types.Int64Value(int64(order.DepartmentOrder)),
+			IsPrimaryDept:   types.BoolValue(order.IsPrimaryDept),
+		})
+	}
+
+	data.CustomAttrs = []UserCustomAttr{}
+	for _, attr := range user.CustomAttrs {
+		genericUserID := ""
+		if attr.Value.GenericUser != nil {
+			genericUserID = attr.Value.GenericUser.ID
+		}
+		data.CustomAttrs = append(data.CustomAttrs, UserCustomAttr{
+			ID:            types.StringValue(attr.ID),
+			Type:          types.StringValue(attr.Type),
+			Text:          types.StringValue(attr.Value.Text),
+			Url:           types.StringValue(attr.Value.Url),
+			PCUrl:         types.StringValue(attr.Value.PCUrl),
+			OptionID:      types.StringValue(attr.Value.OptionID),
+			OptionValue:   types.StringValue(attr.Value.OptionValue),
+			Name:          types.StringValue(attr.Value.Name),
+			PictureUrl:    types.StringValue(attr.Value.PictureUrl),
+			GenericUserID: types.StringValue(genericUserID),
+		})
+	}
+
+	data.DepartmentPaths = []UserDepartmentPath{}
+	for _, departmentPath := range user.DepartmentPath {
+		data.DepartmentPaths = append(data.DepartmentPaths, UserDepartmentPath{
+			DepartmentID:      types.StringValue(departmentPath.DepartmentID),
+			DepartmentName:    types.StringValue(departmentPath.DepartmentName.Name),
+			PathDepartmentIDs: common.StringsToStringValues(departmentPath.DepartmentPath.DepartmentIDs),
+			PathName:          types.StringValue(departmentPath.DepartmentPath.DepartmentPathName.Name),
+		})
+	}
+
+	data.Id = types.StringValue(common.ConstructID(common.DATA_SOURCE, common.USER, user.OpenID))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	local_validator "github.com/aganisatria/terraform-provider-lark/internal/validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &userGroupMemberBindingResource{}
+var _ resource.ResourceWithConfigValidators = &userGroupMemberBindingResource{}
+var _ resource.ResourceWithImportState = &userGroupMemberBindingResource{}
+
+func NewUserGroupMemberBindingResource() resource.Resource {
+	return &userGroupMemberBindingResource{}
+}
+
+// userGroupMemberBindingResource defines the resource implementation.
+// Unlike lark_user_group_member, it only manages a single member and leaves the other members alone.
+type userGroupMemberBindingResource struct {
+	client *common.LarkClient
+}
+
+// userGroupMemberBindingResourceModel describes the resource data model.
+// fields that need to be configured by user.
+type userGroupMemberBindingResourceModel struct {
+	BaseResourceModel
+	UserGroupID types.String `tfsdk:"user_group_id"`
+	MemberID    types.String `tfsdk:"member_id"`
+}
+
+func (r *userGroupMemberBindingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_group_member_binding"
+}
+
+func (r *userGroupMemberBindingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"user_group_id": schema.StringAttribute{
+			Description:         "Unique identity of the user group, unique under a single tenant",
+			MarkdownDescription: "Unique identity of the user group, unique under a single tenant",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"member_id": schema.StringAttribute{
+			Description:         "User group member added to the user group (OpenID of the user)",
+			MarkdownDescription: "User group member added to the user group (OpenID of the user)",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Manages a single user group member in Lark without affecting the other members",
+		MarkdownDescription: "Manages a single user group member in Lark without affecting the other members",
+		Attributes:          attributes,
+	}
+}
+
+func (r *userGroupMemberBindingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *userGroupMemberBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data userGroupMemberBindingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := common.UsergroupMemberAddAPI(ctx, r.client, data.UserGroupID.ValueString(), common.UsergroupMemberAddRequest{
+		Members: []common.UsergroupMember{
+			{
+				MemberID:     data.MemberID.ValueString(),
+				MemberType:   common.UsergroupMemberTypeUser,
+				MemberIDType: common.OpenID,
+			},
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Adding User Group Member", err.Error())
+		return
+	}
+
+	data.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.USER_GROUP_MEMBER_BINDING, data.UserGroupID.ValueString()+"_"+data.MemberID.ValueString()))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *userGroupMemberBindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userGroupMemberBindingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := common.UsergroupMemberGetByMemberTypeAPI(ctx, r.client, state.UserGroupID.ValueString(), "")
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Getting User Group Member", err.Error())
+		return
+	}
+
+	dbMembersIDs := []string{}
+	for _, member := range response.Data.MemberList {
+		dbMembersIDs = append(dbMembersIDs, member.MemberID)
+	}
+
+	// The member was removed outside of terraform, let terraform add it back.
+	if !slices.Contains(dbMembersIDs, state.MemberID.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if state.Id.IsNull() || state.Id.ValueString() == "" {
+		state.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.USER_GROUP_MEMBER_BINDING, state.UserGroupID.ValueString()+"_"+state.MemberID.ValueString()))
+	}
+	state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called since every configurable attribute requires replacement.
+func (r *userGroupMemberBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan userGroupMemberBindingResourceModel
+	var state userGroupMemberBindingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *userGroupMemberBindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userGroupMemberBindingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := common.UsergroupMemberRemoveAPI(ctx, r.client, state.UserGroupID.ValueString(), common.UsergroupMemberRemoveRequest{
+		Members: []common.UsergroupMember{
+			{
+				MemberID:     state.MemberID.ValueString(),
+				MemberType:   common.UsergroupMemberTypeUser,
+				MemberIDType: common.OpenID,
+			},
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Removing User Group Member", err.Error())
+		return
+	}
+}
+
+// ImportState imports the binding using "<user_group_id>:<member_id>".
+func (r *userGroupMemberBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := common.SplitCompositeID(req.ID, 2)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <user_group_id>:<member_id>, %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_group_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member_id"), parts[1])...)
+}
+
+func (r *userGroupMemberBindingResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	if r.client == nil {
+		return []resource.ConfigValidator{}
+	}
+	return []resource.ConfigValidator{
+		local_validator.NewUserIDValidator("member_id", false, false, common.OPEN_ID, r.client),
+	}
+}
@@ -0,0 +1,555 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
+	local_validator "github.com/aganisatria/terraform-provider-lark/internal/validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &userResource{}
+var _ resource.ResourceWithConfigValidators = &userResource{}
+var _ resource.ResourceWithImportState = &userResource{}
+
+func NewUserResource() resource.Resource {
+	return &userResource{}
+}
+
+// userResource defines the resource implementation.
+type userResource struct {
+	client *common.LarkClient
+}
+
+type userDepartmentModel struct {
+	DepartmentID    types.String `tfsdk:"department_id"`
+	UserOrder       types.Int64  `tfsdk:"user_order"`
+	DepartmentOrder types.Int64  `tfsdk:"department_order"`
+	IsPrimaryDept   types.Bool   `tfsdk:"is_primary_dept"`
+}
+
+type userCustomAttrModel struct {
+	ID            types.String `tfsdk:"id"`
+	Type          types.String `tfsdk:"type"`
+	Text          types.String `tfsdk:"text"`
+	Url           types.String `tfsdk:"url"`
+	PCUrl         types.String `tfsdk:"pc_url"`
+	OptionID      types.String `tfsdk:"option_id"`
+	GenericUserID types.String `tfsdk:"generic_user_id"`
+}
+
+// userResourceModel describes the resource data model.
+type userResourceModel struct {
+	BaseResourceModel
+	UserID                       types.String          `tfsdk:"user_id"`
+	OpenID                       types.String          `tfsdk:"open_id"`
+	UnionID                      types.String          `tfsdk:"union_id"`
+	Name                         types.String          `tfsdk:"name"`
+	EnName                       types.String          `tfsdk:"en_name"`
+	Email                        types.String          `tfsdk:"email"`
+	Mobile                       types.String          `tfsdk:"mobile"`
+	Departments                  []userDepartmentModel `tfsdk:"departments"`
+	LeaderUserID                 types.String          `tfsdk:"leader_user_id"`
+	EmployeeType                 types.Int64           `tfsdk:"employee_type"`
+	JobTitle                     types.String          `tfsdk:"job_title"`
+	City                         types.String          `tfsdk:"city"`
+	CustomAttrs                  []userCustomAttrModel `tfsdk:"custom_attrs"`
+	DepartmentChatAcceptorUserID types.String          `tfsdk:"department_chat_acceptor_user_id"`
+	ExternalChatAcceptorUserID   types.String          `tfsdk:"external_chat_acceptor_user_id"`
+	DocsAcceptorUserID           types.String          `tfsdk:"docs_acceptor_user_id"`
+	CalendarAcceptorUserID       types.String          `tfsdk:"calendar_acceptor_user_id"`
+}
+
+func (r *userResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *userResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	baseAttributes := BaseSchemaResourceAttributes()
+	attributes := map[string]schema.Attribute{
+		"user_id": schema.StringAttribute{
+			Description:         "User ID, unique under a single tenant. Generated by Lark when not set. Changing it creates a new user.",
+			MarkdownDescription: "User ID, unique under a single tenant. Generated by Lark when not set. Changing it creates a new user.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"open_id": schema.StringAttribute{
+			Description:         "Open ID of the user.",
+			MarkdownDescription: "Open ID of the user.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"union_id": schema.StringAttribute{
+			Description:         "Union ID of the user.",
+			MarkdownDescription: "Union ID of the user.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description:         "Name of the user.",
+			MarkdownDescription: "Name of the user.",
+			Required:            true,
+		},
+		"en_name": schema.StringAttribute{
+			Description:         "English name of the user.",
+			MarkdownDescription: "English name of the user.",
+			Optional:            true,
+		},
+		"email": schema.StringAttribute{
+			Description:         "Email of the user.",
+			MarkdownDescription: "Email of the user.",
+			Optional:            true,
+		},
+		"mobile": schema.StringAttribute{
+			Description:         "Mobile number of the user, with the country code for numbers outside mainland China, e.g. +6281234567890.",
+			MarkdownDescription: "Mobile number of the user, with the country code for numbers outside mainland China, e.g. `+6281234567890`.",
+			Required:            true,
+		},
+		"departments": schema.ListNestedAttribute{
+			Description:         "Departments of the user.",
+			MarkdownDescription: "Departments of the user.",
+			Required:            true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"department_id": schema.StringAttribute{
+						Description:         "Open department ID of the department, 0 for the root department.",
+						MarkdownDescription: "Open department ID of the department, `0` for the root department.",
+						Required:            true,
+					},
+					"user_order": schema.Int64Attribute{
+						Description:         "Order of the user within the department, higher comes first.",
+						MarkdownDescription: "Order of the user within the department, higher comes first.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"department_order": schema.Int64Attribute{
+						Description:         "Order of the department among the departments of the user, higher comes first.",
+						MarkdownDescription: "Order of the department among the departments of the user, higher comes first.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"is_primary_dept": schema.BoolAttribute{
+						Description:         "Whether this is the primary department of the user. Defaults to the first department.",
+						MarkdownDescription: "Whether this is the primary department of the user. Defaults to the first department.",
+						Optional:            true,
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"leader_user_id": schema.StringAttribute{
+			Description:         "Open ID of the direct leader of the user.",
+			MarkdownDescription: "Open ID of the direct leader of the user.",
+			Optional:            true,
+		},
+		"employee_type": schema.Int64Attribute{
+			Description:         "Employee type, 1 regular, 2 intern, 3 outsourcing, 4 labor and 5 consultant, or the ID of a custom workforce type.",
+			MarkdownDescription: "Employee type, `1` regular, `2` intern, `3` outsourcing, `4` labor and `5` consultant, or the ID of a custom workforce type.",
+			Required:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"job_title": schema.StringAttribute{
+			Description:         "Job title of the user.",
+			MarkdownDescription: "Job title of the user.",
+			Optional:            true,
+		},
+		"city": schema.StringAttribute{
+			Description:         "Work city of the user.",
+			MarkdownDescription: "Work city of the user.",
+			Optional:            true,
+		},
+		"custom_attrs": schema.ListNestedAttribute{
+			Description:         "Custom attributes of the user. The attributes must be enabled in the admin console first.",
+			MarkdownDescription: "Custom attributes of the user. The attributes must be enabled in the admin console first.",
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description:         "ID of the custom attribute.",
+						MarkdownDescription: "ID of the custom attribute.",
+						Required:            true,
+					},
+					"type": schema.StringAttribute{
+						Description:         "Type of the custom attribute, one of TEXT, HREF, ENUMERATION, PICTURE_ENUM and GENERIC_USER.",
+						MarkdownDescription: "Type of the custom attribute, one of `TEXT`, `HREF`, `ENUMERATION`, `PICTURE_ENUM` and `GENERIC_USER`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("TEXT", "HREF", "ENUMERATION", "PICTURE_ENUM", "GENERIC_USER"),
+						},
+					},
+					"text": schema.StringAttribute{
+						Description:         "Value of a TEXT attribute, or the title of a HREF attribute.",
+						MarkdownDescription: "Value of a `TEXT` attribute, or the title of a `HREF` attribute.",
+						Optional:            true,
+					},
+					"url": schema.StringAttribute{
+						Description:         "Link of a HREF attribute.",
+						MarkdownDescription: "Link of a `HREF` attribute.",
+						Optional:            true,
+					},
+					"pc_url": schema.StringAttribute{
+						Description:         "Desktop link of a HREF attribute.",
+						MarkdownDescription: "Desktop link of a `HREF` attribute.",
+						Optional:            true,
+					},
+					"option_id": schema.StringAttribute{
+						Description:         "Selected option of an ENUMERATION or PICTURE_ENUM attribute.",
+						MarkdownDescription: "Selected option of an `ENUMERATION` or `PICTURE_ENUM` attribute.",
+						Optional:            true,
+					},
+					"generic_user_id": schema.StringAttribute{
+						Description:         "Open ID of the user referenced by a GENERIC_USER attribute.",
+						MarkdownDescription: "Open ID of the user referenced by a `GENERIC_USER` attribute.",
+						Optional:            true,
+					},
+				},
+			},
+		},
+		"department_chat_acceptor_user_id": schema.StringAttribute{
+			Description:         "Open ID of the user receiving the internal group chats owned by the user when it is deleted.",
+			MarkdownDescription: "Open ID of the user receiving the internal group chats owned by the user when it is deleted.",
+			Optional:            true,
+		},
+		"external_chat_acceptor_user_id": schema.StringAttribute{
+			Description:         "Open ID of the user receiving the external group chats owned by the user when it is deleted.",
+			MarkdownDescription: "Open ID of the user receiving the external group chats owned by the user when it is deleted.",
+			Optional:            true,
+		},
+		"docs_acceptor_user_id": schema.StringAttribute{
+			Description:         "Open ID of the user receiving the docs owned by the user when it is deleted.",
+			MarkdownDescription: "Open ID of the user receiving the docs owned by the user when it is deleted.",
+			Optional:            true,
+		},
+		"calendar_acceptor_user_id": schema.StringAttribute{
+			Description:         "Open ID of the user receiving the calendars owned by the user when it is deleted.",
+			MarkdownDescription: "Open ID of the user receiving the calendars owned by the user when it is deleted.",
+			Optional:            true,
+		},
+	}
+
+	for k, v := range baseAttributes {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Manages user in Lark. Deleting the resource resigns the user, and hands over their resources to the acceptors.",
+		MarkdownDescription: "Manages user in Lark. Deleting the resource resigns the user, and hands over their resources to the acceptors.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *userResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.LarkClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *LarkClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data userResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := common.UserCreateAPI(ctx, r.client, common.UserCreateRequest{
+		BaseUser: r.modelToRequest(&data),
+		UserID:   data.UserID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Creating User", err.Error())
+		return
+	}
+
+	r.responseToModel(response.Data.User, &data)
+	data.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.USER, response.Data.User.OpenID))
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data userResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := common.UserGetAPI(ctx, r.client, data.OpenID.ValueString(), common.OPEN_ID)
+	if common.IsAPIErrorCode(err, common.USER_NOT_FOUND_CODE) {
+		resp.Diagnostics.AddWarning("User Not Found", fmt.Sprintf("User %s has been deleted outside of Terraform, removing it from the state.", data.OpenID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading User", err.Error())
+		return
+	}
+
+	if response.Data.User.Status.IsResigned {
+		resp.Diagnostics.AddWarning(
+			"User Resigned",
+			fmt.Sprintf("User %s has resigned outside of Terraform, removing it from the state.", data.OpenID.ValueString()),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.responseToModel(response.Data.User, &data)
+	data.Id = types.StringValue(common.ConstructID(common.RESOURCE, common.USER, response.Data.User.OpenID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan userResourceModel
+	var state userResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := common.UserUpdateAPI(ctx, r.client, state.OpenID.ValueString(), common.UserUpdateRequest{
+		BaseUser: r.modelToRequest(&plan),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Updating User", err.Error())
+		return
+	}
+
+	r.responseToModel(response.Data.User, &plan)
+	plan.Id = state.Id
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := common.UserDeleteAPI(ctx, r.client, state.OpenID.ValueString(), common.UserDeleteRequest{
+		DepartmentChatAcceptorUserID: state.DepartmentChatAcceptorUserID.ValueString(),
+		ExternalChatAcceptorUserID:   state.ExternalChatAcceptorUserID.ValueString(),
+		DocsAcceptorUserID:           state.DocsAcceptorUserID.ValueString(),
+		CalendarAcceptorUserID:       state.CalendarAcceptorUserID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Deleting User", err.Error())
+		return
+	}
+}
+
+func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("open_id"), req, resp)
+}
+
+func (r *userResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	if r.client == nil {
+		return []resource.ConfigValidator{}
+	}
+	return []resource.ConfigValidator{
+		local_validator.NewUserIDValidator("leader_user_id", false, false, common.OPEN_ID, r.client),
+		local_validator.NewUserIDValidator("department_chat_acceptor_user_id", false, false, common.OPEN_ID, r.client),
+		local_validator.NewUserIDValidator("external_chat_acceptor_user_id", false, false, common.OPEN_ID, r.client),
+		local_validator.NewUserIDValidator("docs_acceptor_user_id", false, false, common.OPEN_ID, r.client),
+		local_validator.NewUserIDValidator("calendar_acceptor_user_id", false, false, common.OPEN_ID, r.client),
+	}
+}
+
+func (r *userResource) modelToRequest(data *userResourceModel) common.BaseUser {
+	// Lark needs exactly one primary department, the first one is used when none is chosen.
+	hasPrimaryDept := false
+	for _, department := range data.Departments {
+		hasPrimaryDept = hasPrimaryDept || department.IsPrimaryDept.ValueBool()
+	}
+
+	departmentIDs := []string{}
+	orders := []common.Order{}
+	for i, department := range data.Departments {
+		departmentIDs = append(departmentIDs, department.DepartmentID.ValueString())
+		orders = append(orders, common.Order{
+			DepartmentID:    department.DepartmentID.ValueString(),
+			UserOrder:       int(department.UserOrder.ValueInt64()),
+			DepartmentOrder: int(department.DepartmentOrder.ValueInt64()),
+			IsPrimaryDept:   department.IsPrimaryDept.ValueBool() || (!hasPrimaryDept && i == 0),
+		})
+	}
+
+	customAttrs := []common.CustomAttr{}
+	for _, attr := range data.CustomAttrs {
+		customAttr := common.CustomAttr{
+			ID:   attr.ID.ValueString(),
+			Type: attr.Type.ValueString(),
+			Value: common.CustomAttrValue{
+				Text:     attr.Text.ValueString(),
+				Url:      attr.Url.ValueString(),
+				PCUrl:    attr.PCUrl.ValueString(),
+				OptionID: attr.OptionID.ValueString(),
+			},
+		}
+		if attr.GenericUserID.ValueString() != "" {
+			customAttr.Value.GenericUser = &common.CustomAttrGenericUser{
+				ID:   attr.GenericUserID.ValueString(),
+				Type: 1,
+			}
+		}
+		customAttrs = append(customAttrs, customAttr)
+	}
+
+	return common.BaseUser{
+		Name:          data.Name.ValueString(),
+		EnName:        data.EnName.ValueString(),
+		Email:         data.Email.ValueString(),
+		Mobile:        data.Mobile.ValueString(),
+		DepartmentIDs: departmentIDs,
+		LeaderUserID:  data.LeaderUserID.ValueString(),
+		City:          data.City.ValueString(),
+		EmployeeType:  int(data.EmployeeType.ValueInt64()),
+		JobTitle:      data.JobTitle.ValueString(),
+		Orders:        orders,
+		CustomAttrs:   customAttrs,
+	}
+}
+
+// responseToModel refreshes the model from the user returned by Lark.
+// Optional values that are empty in Lark stay null, so an unset attribute doesn't show a diff.
+func (r *userResource) responseToModel(user common.User, data *userResourceModel) {
+	data.UserID = types.StringValue(user.UserID)
+	data.OpenID = types.StringValue(user.OpenID)
+	data.UnionID = types.StringValue(user.UnionID)
+	data.Name = types.StringValue(user.Name)
+	data.EnName = optionalStringValue(data.EnName, user.EnName)
+	data.Email = optionalStringValue(data.Email, user.Email)
+	data.LeaderUserID = optionalStringValue(data.LeaderUserID, user.LeaderUserID)
+	data.JobTitle = optionalStringValue(data.JobTitle, user.JobTitle)
+	data.City = optionalStringValue(data.City, user.City)
+	data.EmployeeType = types.Int64Value(int64(user.EmployeeType))
+
+	// Lark adds the country code to mainland China numbers.
+	if !strings.HasSuffix(user.Mobile, data.Mobile.ValueString()) || data.Mobile.ValueString() == "" {
+		data.Mobile = types.StringValue(user.Mobile)
+	}
+
+	// The departments keep the configured order, departments added outside of Terraform come last.
+	orders := slices.Clone(user.Orders)
+	departments := []userDepartmentModel{}
+	for _, department := range data.Departments {
+		i := slices.IndexFunc(orders, func(order common.Order) bool {
+			return order.DepartmentID == department.DepartmentID.ValueString()
+		})
+		if i < 0 {
+			continue
+		}
+		departments = append(departments, userDepartmentModelOf(orders[i]))
+		orders = slices.Delete(orders, i, i+1)
+	}
+	for _, order := range orders {
+		departments = append(departments, userDepartmentModelOf(order))
+	}
+	if len(departments) > 0 {
+		data.Departments = departments
+	}
+
+	// Only the custom attributes managed by the resource are refreshed.
+	customAttrs := []userCustomAttrModel{}
+	for _, attr := range data.CustomAttrs {
+		for _, remote := range user.CustomAttrs {
+			if remote.ID != attr.ID.ValueString() {
+				continue
+			}
+
+			refreshed := userCustomAttrModel{
+				ID:            types.StringValue(remote.ID),
+				Type:          types.StringValue(remote.Type),
+				Text:          optionalStringValue(attr.Text, remote.Value.Text),
+				Url:           optionalStringValue(attr.Url, remote.Value.Url),
+				PCUrl:         optionalStringValue(attr.PCUrl, remote.Value.PCUrl),
+				OptionID:      optionalStringValue(attr.OptionID, remote.Value.OptionID),
+				GenericUserID: attr.GenericUserID,
+			}
+			if remote.Value.GenericUser != nil {
+				refreshed.GenericUserID = optionalStringValue(attr.GenericUserID, remote.Value.GenericUser.ID)
+			}
+			customAttrs = append(customAttrs, refreshed)
+		}
+	}
+	if data.CustomAttrs != nil {
+		data.CustomAttrs = customAttrs
+	}
+}
+
+// userDepartmentModelOf converts a department of the user returned by Lark.
+func userDepartmentModelOf(order common.Order) userDepartmentModel {
+	return userDepartmentModel{
+		DepartmentID:    types.StringValue(order.DepartmentID),
+		UserOrder:       types.Int64Value(int64(order.UserOrder)),
+		DepartmentOrder: types.Int64Value(int64(order.DepartmentOrder)),
+		IsPrimaryDept:   types.BoolValue(order.IsPrimaryDept),
+	}
+}
+
+// optionalStringValue keeps a null value null when the remote value is empty.
+func optionalStringValue(current types.String, remote string) types.String {
+	if remote == "" && current.IsNull() {
+		return current
+	}
+	return types.StringValue(remote)
+}
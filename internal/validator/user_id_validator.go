@@ -63,6 +63,10 @@ func (v UserIDValidator) ValidateResource(ctx context.Context, req resource.Vali
 			return
 		}
 
+		if v.doesSkipAppID && strings.HasPrefix(id.ValueString(), "cli_") {
+			return
+		}
+
 		availableIDs = append(availableIDs, id.ValueString())
 	} else {
 		var ids []types.String
@@ -8,6 +8,7 @@ import (
 	"flag"
 	"log"
 
+	"github.com/aganisatria/terraform-provider-lark/internal/common"
 	"github.com/aganisatria/terraform-provider-lark/internal/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 )
@@ -31,7 +32,7 @@ func main() {
 		// TODO: Update this string with the published name of your provider.
 		// Also update the tfplugindocs generate command to either remove the
 		// -provider-name flag or set its value to the updated provider name.
-		Address: "registry.terraform.io/aganisatria/lark",
+		Address: common.PROVIDER_ADDRESS,
 		Debug:   debug,
 	}
 